@@ -91,6 +91,30 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 	return idObj, nil
 }
 
+// ParseAzureResourceIDInsensitively converts a long-form Azure Resource Manager ID
+// into a ResourceID, where the `subscriptions`, `resourceGroups` and `providers`
+// segments are matched case-insensitively - since some Azure APIs return these
+// using a different casing (e.g. `resourcegroups`). The other segments within the
+// Path retain the casing used in the ID, so should be retrieved using
+// PopSegmentInsensitively.
+func ParseAzureResourceIDInsensitively(id string) (*ResourceID, error) {
+	if !strings.HasPrefix(id, "/") {
+		return ParseAzureResourceID(id)
+	}
+
+	// the first component is empty since the ID is prefixed with a `/`, so the keys are at the odd indexes
+	components := strings.Split(id, "/")
+	for i := 1; i < len(components); i += 2 {
+		for _, key := range []string{"subscriptions", "resourceGroups", "providers"} {
+			if strings.EqualFold(components[i], key) {
+				components[i] = key
+			}
+		}
+	}
+
+	return ParseAzureResourceID(strings.Join(components, "/"))
+}
+
 // PopSegment retrieves a segment from the Path and returns it
 // if found it removes it from the Path then return the value
 // if not found, this returns nil
@@ -104,6 +128,20 @@ func (id *ResourceID) PopSegment(name string) (string, error) {
 	return val, nil
 }
 
+// PopSegmentInsensitively retrieves a segment from the Path, where the name
+// of the segment is matched case-insensitively, and returns it - removing it
+// from the Path. If the segment isn't found an error is returned.
+func (id *ResourceID) PopSegmentInsensitively(name string) (string, error) {
+	for key, val := range id.Path {
+		if strings.EqualFold(key, name) {
+			delete(id.Path, key)
+			return val, nil
+		}
+	}
+
+	return "", fmt.Errorf("ID was missing the `%s` element", name)
+}
+
 // ValidateNoEmptySegments validates ...
 func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
 	if len(id.Path) == 0 {
@@ -175,3 +175,90 @@ func TestParseAzureResourceID(t *testing.T) {
 		}
 	}
 }
+
+func TestParseAzureResourceIDInsensitively(t *testing.T) {
+	testCases := []struct {
+		id                 string
+		expectedResourceID *ResourceID
+		expectError        bool
+	}{
+		{
+			"random",
+			nil,
+			true,
+		},
+		{
+			"/SUBSCRIPTIONS/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/RESOURCEGROUPS/testGroup1",
+			&ResourceID{
+				SubscriptionID: "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+				ResourceGroup:  "testGroup1",
+				Provider:       "",
+				Path:           map[string]string{},
+			},
+			false,
+		},
+		{
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourcegroups/testGroup1/Providers/Microsoft.Network/VirtualNetworks/virtualNetwork1",
+			&ResourceID{
+				SubscriptionID: "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+				ResourceGroup:  "testGroup1",
+				Provider:       "Microsoft.Network",
+				Path: map[string]string{
+					"VirtualNetworks": "virtualNetwork1",
+				},
+			},
+			false,
+		},
+		{
+			// the values should retain their casing
+			"/subscriptions/11111111-1111-1111-1111-111111111111/Providers/Microsoft.ApiManagement/service/service1/Subscriptions/PROVIDERS",
+			&ResourceID{
+				SubscriptionID: "11111111-1111-1111-1111-111111111111",
+				Provider:       "Microsoft.ApiManagement",
+				Path: map[string]string{
+					"service":       "service1",
+					"subscriptions": "PROVIDERS",
+				},
+			},
+			false,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.id)
+		parsed, err := ParseAzureResourceIDInsensitively(test.id)
+		if test.expectError && err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(test.expectedResourceID, parsed) {
+			t.Fatalf("Unexpected resource ID:\nExpected: %+v\nGot:      %+v\n", test.expectedResourceID, parsed)
+		}
+	}
+}
+
+func TestPopSegmentInsensitively(t *testing.T) {
+	id := ResourceID{
+		Path: map[string]string{
+			"VirtualNetworks": "virtualNetwork1",
+		},
+	}
+
+	if _, err := id.PopSegmentInsensitively("subnets"); err == nil {
+		t.Fatalf("Expected an error when the segment doesn't exist but didn't get one")
+	}
+
+	value, err := id.PopSegmentInsensitively("virtualNetworks")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if value != "virtualNetwork1" {
+		t.Fatalf("Expected %q but got %q", "virtualNetwork1", value)
+	}
+	if len(id.Path) != 0 {
+		t.Fatalf("Expected the segment to be removed from the Path but got %+v", id.Path)
+	}
+}
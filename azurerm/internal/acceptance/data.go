@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 func init() {
@@ -84,6 +85,11 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// the names of the resources need to match those used when the requests were recorded
+	if recordingMode(t) == recording.ModeReplay {
+		loadRecordedTestData(t, &testData)
+	}
+
 	return testData
 }
 
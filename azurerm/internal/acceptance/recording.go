@@ -0,0 +1,102 @@
+package acceptance
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azuread/azuread"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
+)
+
+// the names of the Variables stored alongside the recorded requests, so that the
+// same (otherwise random) values are used when these requests are replayed
+const (
+	recordingVariableRandomInteger     = "random_integer"
+	recordingVariableRandomString      = "random_string"
+	recordingVariableLocationPrimary   = "location_primary"
+	recordingVariableLocationSecondary = "location_secondary"
+	recordingVariableLocationTernary   = "location_ternary"
+)
+
+// recordingMode returns the Mode the Acceptance Tests are being recorded/replayed in
+// (if any) - which is specified using the `ARM_TEST_RECORDING_MODE` Environment Variable
+func recordingMode(t *testing.T) recording.Mode {
+	mode, err := recording.ModeFromEnvironment()
+	if err != nil {
+		t.Fatalf("determining the recording mode: %+v", err)
+	}
+	return mode
+}
+
+// loadRecordedTestData replaces the random values within the TestData with those
+// stored when the requests for this test were recorded
+func loadRecordedTestData(t *testing.T, data *TestData) {
+	recorder, err := recording.NewRecorder(recording.ModeReplay, recording.CassettePath(t.Name()))
+	if err != nil {
+		t.Fatalf("loading the recorded requests: %+v", err)
+	}
+
+	if v, ok := recorder.Variable(recordingVariableRandomInteger); ok {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			t.Fatalf("parsing the recorded %q: %+v", recordingVariableRandomInteger, err)
+		}
+		data.RandomInteger = i
+	}
+	if v, ok := recorder.Variable(recordingVariableRandomString); ok {
+		data.RandomString = v
+	}
+	if v, ok := recorder.Variable(recordingVariableLocationPrimary); ok {
+		data.Locations.Primary = v
+	}
+	if v, ok := recorder.Variable(recordingVariableLocationSecondary); ok {
+		data.Locations.Secondary = v
+	}
+	if v, ok := recorder.Variable(recordingVariableLocationTernary); ok {
+		data.Locations.Ternary = v
+	}
+}
+
+// runRecordedTest runs the test case using a Provider which records (or replays) the requests
+// sent to Azure into (or from) the `testdata/recordings` directory of the package being tested
+func (td TestData) runRecordedTest(t *testing.T, testCase resource.TestCase, mode recording.Mode) {
+	recorder, err := recording.NewRecorder(mode, recording.CassettePath(t.Name()))
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+
+	recorder.SetVariable(recordingVariableRandomInteger, strconv.Itoa(td.RandomInteger))
+	recorder.SetVariable(recordingVariableRandomString, td.RandomString)
+	recorder.SetVariable(recordingVariableLocationPrimary, td.Locations.Primary)
+	recorder.SetVariable(recordingVariableLocationSecondary, td.Locations.Secondary)
+	recorder.SetVariable(recordingVariableLocationTernary, td.Locations.Ternary)
+
+	// the Check functions use the shared Provider, so this is replaced for the duration of the test
+	// NOTE: recorded tests are run sequentially, so there's no other test using the shared Provider
+	azureProvider := provider.TestAzureProviderWithRecorder(recorder).(*schema.Provider)
+	previousProvider := AzureProvider
+	AzureProvider = azureProvider
+	defer func() {
+		AzureProvider = previousProvider
+	}()
+
+	testCase.Providers = map[string]terraform.ResourceProvider{
+		"azurerm": azureProvider,
+		"azuread": azuread.Provider().(*schema.Provider),
+	}
+
+	// the requests sent to Azure need to happen in a consistent order to be replayed
+	resource.Test(t, testCase)
+
+	if t.Failed() {
+		return
+	}
+
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("saving the recorded requests: %+v", err)
+	}
+}
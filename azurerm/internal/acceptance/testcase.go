@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 // NOTE: when Binary Testing is enabled the Check functions will need to build a client rather than relying on the
@@ -115,6 +116,11 @@ func buildClient() (*clients.Client, error) {
 }
 
 func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
+	if mode := recordingMode(t); mode != recording.ModeDisabled && !enableBinaryTesting {
+		td.runRecordedTest(t, testCase, mode)
+		return
+	}
+
 	if enableBinaryTesting {
 		testCase.DisableBinaryDriver = false //nolint:SA1019
 		testCase.ProviderFactories = map[string]terraform.ResourceProviderFactory{
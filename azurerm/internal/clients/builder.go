@@ -13,6 +13,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 )
 
@@ -21,6 +22,8 @@ type ClientBuilder struct {
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
+	Recorder                    *recording.Recorder
+	RetryPolicy                 common.RetryPolicy
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
@@ -55,12 +58,23 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, err
 	}
 
+	if builder.Recorder != nil && builder.Recorder.Mode() == recording.ModeReplay {
+		return buildForReplay(ctx, builder, *env)
+	}
+
 	// client declarations:
 	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
 
+	if builder.Recorder != nil {
+		builder.Recorder.Scrub(account.SubscriptionId, recording.SubscriptionIDPlaceholder)
+		builder.Recorder.Scrub(account.TenantId, recording.TenantIDPlaceholder)
+		builder.Recorder.Scrub(account.ClientId, recording.ClientIDPlaceholder)
+		builder.Recorder.Scrub(account.ObjectId, recording.ObjectIDPlaceholder)
+	}
+
 	client := Client{
 		Account: account,
 	}
@@ -128,6 +142,8 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		Environment:                 *env,
 		Features:                    builder.Features,
+		Recorder:                    builder.Recorder,
+		RetryPolicy:                 builder.RetryPolicy,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
 	}
 
@@ -142,3 +158,48 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	return &client, nil
 }
+
+// buildForReplay builds a Client which replays the requests recorded by the Recorder - since
+// requests aren't sent to Azure there's no need to authenticate, so the identifiers used are
+// the placeholders which replaced the real values when the requests were recorded
+func buildForReplay(ctx context.Context, builder ClientBuilder, env azure.Environment) (*Client, error) {
+	client := Client{
+		Account: &ResourceManagerAccount{
+			AuthenticatedAsAServicePrincipal: true,
+			ClientId:                         recording.ClientIDPlaceholder,
+			Environment:                      env,
+			ObjectId:                         recording.ObjectIDPlaceholder,
+			SkipResourceProviderRegistration: builder.SkipProviderRegistration,
+			SubscriptionId:                   recording.SubscriptionIDPlaceholder,
+			TenantId:                         recording.TenantIDPlaceholder,
+		},
+	}
+
+	o := &common.ClientOptions{
+		SubscriptionId:              recording.SubscriptionIDPlaceholder,
+		TenantID:                    recording.TenantIDPlaceholder,
+		PartnerId:                   builder.PartnerId,
+		TerraformVersion:            builder.TerraformVersion,
+		GraphAuthorizer:             autorest.NullAuthorizer{},
+		GraphEndpoint:               env.GraphEndpoint,
+		KeyVaultAuthorizer:          autorest.NullAuthorizer{},
+		ResourceManagerAuthorizer:   autorest.NullAuthorizer{},
+		ResourceManagerEndpoint:     env.ResourceManagerEndpoint,
+		StorageAuthorizer:           autorest.NullAuthorizer{},
+		SynapseAuthorizer:           autorest.NullAuthorizer{},
+		SkipProviderReg:             builder.SkipProviderRegistration,
+		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
+		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		Environment:                 env,
+		Features:                    builder.Features,
+		Recorder:                    builder.Recorder,
+		RetryPolicy:                 builder.RetryPolicy,
+		StorageUseAzureAD:           builder.StorageUseAzureAD,
+	}
+
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("Error building Client: %+v", err)
+	}
+
+	return &client, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	advisor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/advisor/client"
 	analysisServices "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/analysisservices/client"
 	apiManagement "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/client"
@@ -92,6 +93,7 @@ import (
 	synapse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/client"
 	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
 	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type Client struct {
@@ -101,6 +103,22 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// DefaultTags are the Tags defined in the `default_tags` block of the Provider, which
+	// are merged into the Tags assigned to each Resource supporting Tags
+	DefaultTags map[string]string
+
+	// IgnoredTags are the Tags defined in the `ignore_tags` block of the Provider, which are
+	// managed outside of Terraform and so aren't read into (or removed from) each Resource
+	IgnoredTags tags.IgnoreConfig
+
+	// ResourceProviderRegistrar registers the Resource Providers used by each Resource and Data Source on-demand,
+	// which is nil unless `resource_provider_registration_mode` is set to `used` in the Provider
+	ResourceProviderRegistrar *resourceproviders.Registrar
+
+	// CorrelationRequestID is the value sent in the `x-ms-correlation-request-id` header
+	// this is empty when the Correlation Request ID has been disabled
+	CorrelationRequestID string
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
@@ -197,6 +215,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	if !o.DisableCorrelationRequestID {
+		client.CorrelationRequestID = common.CorrelationRequestID()
+	}
 
 	client.Advisor = advisor.NewClient(o)
 	client.AnalysisServices = analysisServices.NewClient(o)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -32,6 +33,8 @@ type ClientOptions struct {
 	DisableTerraformPartnerID   bool
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
+	Recorder                    *recording.Recorder
+	RetryPolicy                 RetryPolicy
 	StorageUseAzureAD           bool
 }
 
@@ -39,11 +42,22 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	decorators := make([]autorest.SendDecorator, 0)
+	if o.Recorder != nil {
+		// this needs to be applied first so that each retry is recorded (and replayed)
+		decorators = append(decorators, o.Recorder.SendDecorator())
+	}
+	decorators = append(decorators, withRetryPolicy(o.RetryPolicy))
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), decorators...)
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		c.RequestInspector = withCorrelationRequestID(correlationRequestID())
 	}
+	if o.RetryPolicy.MaxRetries > 0 {
+		// requests are retried according to the RetryPolicy, so this replaces the retries made by autorest
+		// (which would otherwise multiply the number of attempts) - whilst still registering Resource Providers
+		c.SendDecorators = []autorest.SendDecorator{withResourceProviderRegistration(*c)}
+	}
 }
 
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
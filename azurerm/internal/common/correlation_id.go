@@ -24,6 +24,12 @@ func withCorrelationRequestID(uuid string) autorest.PrepareDecorator {
 	return autorest.WithHeader(HeaderCorrelationRequestID, uuid)
 }
 
+// CorrelationRequestID returns the UUID passed through the `x-ms-correlation-request-id` header
+// which is generated once per run of the Provider, allowing it to be included in log output
+func CorrelationRequestID() string {
+	return correlationRequestID()
+}
+
 // correlationRequestID generates an UUID to pass through `x-ms-correlation-request-id` header.
 func correlationRequestID() string {
 	msCorrelationRequestIDOnce.Do(func() {
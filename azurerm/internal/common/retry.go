@@ -0,0 +1,186 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// RetryPolicy defines how requests which are throttled (or fail with a transient error)
+// are retried by each of the Azure SDK Clients
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried, where zero disables these retries.
+	//
+	// NOTE: when enabled the retries made by autorest (see `azure.DoRetryWithRegistration`) are disabled,
+	// such that a request is sent at most `MaxRetries + 1` times
+	MaxRetries int
+
+	// MinBackoff is the delay prior to the first retry, which is doubled for each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the maximum delay between retries, where zero means the delay isn't capped
+	MaxBackoff time.Duration
+
+	// HonorRetryAfter specifies whether the delay specified in the `Retry-After` header
+	// of the response (if any) should be used, rather than the exponential backoff
+	HonorRetryAfter bool
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when one isn't configured in the Provider block
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:      3,
+		MinBackoff:      2 * time.Second,
+		MaxBackoff:      60 * time.Second,
+		HonorRetryAfter: true,
+	}
+}
+
+// statusCodesForRetry are the status codes which are retried - notably this doesn't include
+// a 500 since the request may have been (partially) processed, in which case it's not safe to retry
+var statusCodesForRetry = []int{
+	http.StatusRequestTimeout,     // 408
+	http.StatusTooManyRequests,    // 429
+	http.StatusBadGateway,         // 502
+	http.StatusServiceUnavailable, // 503
+	http.StatusGatewayTimeout,     // 504
+}
+
+// withRetryPolicy returns a SendDecorator which retries requests which were throttled
+// (or failed with a transient error) according to the RetryPolicy
+func withRetryPolicy(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if policy.MaxRetries <= 0 {
+			return s
+		}
+
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				autorest.DrainResponseBody(resp)
+				resp, err = s.Do(rr.Request())
+				if err != nil || !autorest.ResponseHasStatusCode(resp, statusCodesForRetry...) || attempt >= policy.MaxRetries {
+					return resp, err
+				}
+
+				delay := policy.delay(resp, attempt)
+				log.Printf("[DEBUG] %s %s returned %d - retrying in %s (retry %d of %d)", r.Method, r.URL, resp.StatusCode, delay, attempt+1, policy.MaxRetries)
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// withResourceProviderRegistration returns a SendDecorator which is used in place of `azure.DoRetryWithRegistration`
+// when the RetryPolicy is enabled - sending the request once (since the Sender retries it according to the RetryPolicy)
+// and only handing the request over to autorest when the Resource Provider needs to be registered
+func withResourceProviderRegistration(client autorest.Client) autorest.SendDecorator {
+	// autorest sends the request again once the Resource Provider has been registered
+	client.RetryAttempts = 2
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || client.SkipResourceProviderRegistration || !autorest.ResponseHasStatusCode(resp, http.StatusConflict) {
+				return resp, err
+			}
+
+			if !isMissingSubscriptionRegistration(resp) {
+				return resp, nil
+			}
+
+			autorest.DrainResponseBody(resp)
+			if err := rr.Prepare(); err != nil {
+				return resp, err
+			}
+
+			log.Printf("[DEBUG] %s %s returned %d since the Resource Provider isn't registered - registering it", r.Method, r.URL, resp.StatusCode)
+			return azure.DoRetryWithRegistration(client)(s).Do(rr.Request())
+		})
+	}
+}
+
+// isMissingSubscriptionRegistration returns whether the response is an error since the Resource Provider isn't
+// registered - the body of the response is restored so that it can be read by the caller
+func isMissingSubscriptionRegistration(resp *http.Response) bool {
+	if resp.Body == nil {
+		return false
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	var re azure.RequestError
+	if err := json.Unmarshal(body, &re); err != nil {
+		return false
+	}
+
+	return re.ServiceError != nil && re.ServiceError.Code == "MissingSubscriptionRegistration"
+}
+
+// delay returns the duration to wait prior to retrying the request for the specified (zero-based) attempt
+func (p RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if p.HonorRetryAfter {
+		if v := retryAfter(resp); v > 0 {
+			return v
+		}
+	}
+
+	delay := time.Duration(float64(p.MinBackoff) * math.Pow(2, float64(attempt)))
+	if p.MaxBackoff > 0 && (delay > p.MaxBackoff || delay < 0) {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// retryAfter returns the delay specified in the `Retry-After` header, which can either
+// be a number of seconds or a date in RFC1123 format - or zero if this isn't specified
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if t, err := time.Parse(time.RFC1123, v); err == nil {
+		return time.Until(t)
+	}
+
+	return 0
+}
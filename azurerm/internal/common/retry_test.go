@@ -0,0 +1,378 @@
+package common
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// newThrottlingServer returns a server which returns the specified responses in order, followed by a 200
+func newThrottlingServer(t *testing.T, responses []func(w http.ResponseWriter)) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method == http.MethodPut && string(body) != `{"hello":"world"}` {
+			t.Errorf("expected the request body to be sent on each attempt but got %q", string(body))
+		}
+
+		i := int(atomic.AddInt32(&requests, 1)) - 1
+		if i < len(responses) {
+			responses[i](w)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	return server, &requests
+}
+
+func throttled(retryAfter string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+}
+
+func statusCode(code int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(code)
+	}
+}
+
+func sendWithRetryPolicy(t *testing.T, ctx context.Context, url string, policy RetryPolicy) (*http.Response, error) {
+	client := autorest.NewClientWithUserAgent("")
+	ClientOptions{
+		DisableCorrelationRequestID: true,
+		RetryPolicy:                 policy,
+	}.ConfigureClient(&client, autorest.NullAuthorizer{})
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, strings.NewReader(`{"hello":"world"}`))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	return client.Send(req)
+}
+
+func TestRetryPolicyRetriesThrottledRequests(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled(""),
+		statusCode(http.StatusServiceUnavailable),
+		throttled(""),
+	})
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+	resp, err := sendWithRetryPolicy(t, context.Background(), server.URL, policy)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 4 {
+		t.Fatalf("expected 4 requests but got %d", actual)
+	}
+}
+
+func TestRetryPolicyStopsAfterMaxRetries(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled(""),
+		throttled(""),
+		throttled(""),
+	})
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 2,
+		MinBackoff: time.Millisecond,
+	}
+	resp, err := sendWithRetryPolicy(t, context.Background(), server.URL, policy)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 3 {
+		t.Fatalf("expected 3 requests but got %d", actual)
+	}
+}
+
+func TestRetryPolicyDisabled(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled(""),
+	})
+	defer server.Close()
+
+	resp, err := sendWithRetryPolicy(t, context.Background(), server.URL, RetryPolicy{})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryPolicyWithAzureSDKRetries(t *testing.T) {
+	// the Azure SDK Clients send requests using `azure.DoRetryWithRegistration`, which retries a throttled
+	// request up to `RetryAttempts` times - which is replaced by the RetryPolicy when it's enabled
+	testData := []struct {
+		maxRetries int
+		expected   int32
+	}{
+		{maxRetries: 0, expected: 4},
+		{maxRetries: 2, expected: 3},
+	}
+	for _, v := range testData {
+		// every request is throttled, so that each of the retries is made
+		responses := make([]func(w http.ResponseWriter), 0)
+		for i := int32(0); i <= v.expected; i++ {
+			responses = append(responses, throttled(""))
+		}
+		server, requests := newThrottlingServer(t, responses)
+
+		client := autorest.NewClientWithUserAgent("")
+		ClientOptions{
+			DisableCorrelationRequestID: true,
+			RetryPolicy: RetryPolicy{
+				MaxRetries: v.maxRetries,
+				MinBackoff: time.Millisecond,
+			},
+		}.ConfigureClient(&client, autorest.NullAuthorizer{})
+		client.RetryDuration = time.Millisecond
+
+		req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"hello":"world"}`))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Fatalf("expected a 429 but got %d", resp.StatusCode)
+		}
+		if actual := atomic.LoadInt32(requests); actual != v.expected {
+			t.Fatalf("expected %d requests with `max_retries` set to %d but got %d", v.expected, v.maxRetries, actual)
+		}
+
+		server.Close()
+	}
+}
+
+// newRegistrationServer returns a server where the first request for a resource fails since the
+// Resource Provider isn't registered, alongside the number of requests made for the resource
+func newRegistrationServer(t *testing.T) (*httptest.Server, *int32) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/register",
+			"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"registrationState":"Registered"}`))
+			return
+		}
+
+		if atomic.AddInt32(&requests, 1) > 1 {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Example'.","details":[{"code":"MissingSubscriptionRegistration","target":"Microsoft.Example"}]}}`))
+	}))
+	return server, &requests
+}
+
+func TestRetryPolicyRegistersResourceProviders(t *testing.T) {
+	testData := []struct {
+		skipProviderRegistration bool
+		expectedStatusCode       int
+		expectedRequests         int32
+	}{
+		{skipProviderRegistration: false, expectedStatusCode: http.StatusOK, expectedRequests: 2},
+		{skipProviderRegistration: true, expectedStatusCode: http.StatusConflict, expectedRequests: 1},
+	}
+	for _, v := range testData {
+		server, requests := newRegistrationServer(t)
+
+		client := autorest.NewClientWithUserAgent("")
+		ClientOptions{
+			DisableCorrelationRequestID: true,
+			RetryPolicy: RetryPolicy{
+				MaxRetries: 2,
+				MinBackoff: time.Millisecond,
+			},
+			SkipProviderReg: v.skipProviderRegistration,
+		}.ConfigureClient(&client, autorest.NullAuthorizer{})
+		client.RetryDuration = time.Millisecond
+
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/things/example", strings.NewReader(`{"hello":"world"}`))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp, err := client.Send(req, azure.DoRetryWithRegistration(client))
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if resp.StatusCode != v.expectedStatusCode {
+			t.Fatalf("expected a %d but got %d", v.expectedStatusCode, resp.StatusCode)
+		}
+		if v.expectedStatusCode == http.StatusConflict {
+			// the body of the response should still be available to the caller
+			body, _ := ioutil.ReadAll(resp.Body)
+			if !strings.Contains(string(body), "MissingSubscriptionRegistration") {
+				t.Fatalf("expected the body of the response to be available but got %q", string(body))
+			}
+		}
+		if actual := atomic.LoadInt32(requests); actual != v.expectedRequests {
+			t.Fatalf("expected %d requests with `skip_provider_registration` set to %t but got %d", v.expectedRequests, v.skipProviderRegistration, actual)
+		}
+
+		server.Close()
+	}
+}
+
+func TestRetryPolicyDoesNotRetryInternalServerErrors(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		statusCode(http.StatusInternalServerError),
+	})
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+	}
+	resp, err := sendWithRetryPolicy(t, context.Background(), server.URL, policy)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected a 500 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryPolicyHonorsRetryAfter(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled("1"),
+	})
+	defer server.Close()
+
+	// the backoff is long enough that the test would time out if the Retry-After header was ignored
+	policy := RetryPolicy{
+		MaxRetries:      1,
+		MinBackoff:      time.Hour,
+		HonorRetryAfter: true,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	started := time.Now()
+	resp, err := sendWithRetryPolicy(t, ctx, server.URL, policy)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 2 {
+		t.Fatalf("expected 2 requests but got %d", actual)
+	}
+	if elapsed := time.Since(started); elapsed < time.Second {
+		t.Fatalf("expected the request to be retried after at least 1s but it was retried after %s", elapsed)
+	}
+}
+
+func TestRetryPolicyIgnoresRetryAfter(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled("3600"),
+	})
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries:      1,
+		MinBackoff:      time.Millisecond,
+		HonorRetryAfter: false,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := sendWithRetryPolicy(t, ctx, server.URL, policy)
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if actual := atomic.LoadInt32(requests); actual != 2 {
+		t.Fatalf("expected 2 requests but got %d", actual)
+	}
+}
+
+func TestRetryPolicyHonorsContextCancellation(t *testing.T) {
+	server, requests := newThrottlingServer(t, []func(w http.ResponseWriter){
+		throttled(""),
+	})
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Hour,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := sendWithRetryPolicy(t, ctx, server.URL, policy); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if actual := atomic.LoadInt32(requests); actual != 1 {
+		t.Fatalf("expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{
+		MinBackoff: 2 * time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+
+	testData := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 0, expected: 2 * time.Second},
+		{attempt: 1, expected: 4 * time.Second},
+		{attempt: 2, expected: 8 * time.Second},
+		{attempt: 3, expected: 10 * time.Second},
+		{attempt: 100, expected: 10 * time.Second},
+	}
+	for _, v := range testData {
+		if actual := policy.delay(nil, v.attempt); actual != v.expected {
+			t.Fatalf("expected a delay of %s for attempt %d but got %s", v.expected, v.attempt, actual)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package deprecations
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ApplyReporting updates the Data Source (or Resource) so that the usage of any Deprecations in the Registry which
+// apply to it are recorded by the Reporter at plan time.
+//
+// Deprecated Data Sources and Resources are recorded when they're planned (or for a Data Source, read). Deprecated
+// fields are recorded when they're validated, which only happens when they're set in the configuration - however
+// values which are unknown (e.g. those referencing a Resource yet to be created) aren't validated, so these are also
+// recorded when planning a Resource (but only for top-level fields which aren't Computed). Since references to
+// Attributes (Computed-only fields) can't be detected by the Provider, deprecated Attributes are recorded whenever
+// the Data Source/Resource is used.
+func ApplyReporting(name string, resource *schema.Resource, isDataSource bool, reporter *Reporter) {
+	if resource == nil || reporter == nil {
+		return
+	}
+
+	deprecations := ForDataSourceOrResource(name, isDataSource)
+	if len(deprecations) == 0 {
+		return
+	}
+
+	// recorded whenever the Data Source/Resource is used
+	usedDeprecations := make([]Deprecation, 0)
+	usedAttributes := make([]Deprecation, 0)
+
+	// recorded when planning a Resource, should the value be unknown
+	unknownDeprecations := make([]Deprecation, 0)
+
+	for _, deprecation := range deprecations {
+		deprecation := deprecation
+
+		if deprecation.Field() == "" {
+			usedDeprecations = append(usedDeprecations, deprecation)
+			continue
+		}
+
+		path := strings.Split(deprecation.Field(), ".")
+		field := findField(resource.Schema, path)
+		if field == nil {
+			continue
+		}
+
+		if isAttribute(field) {
+			usedAttributes = append(usedAttributes, deprecation)
+			continue
+		}
+
+		resource.Schema = withRecordedField(resource.Schema, path, func() {
+			reporter.Record(deprecation, false)
+		})
+
+		if len(path) == 1 && !field.Computed {
+			unknownDeprecations = append(unknownDeprecations, deprecation)
+		}
+	}
+
+	recordUsed := func() {
+		for _, v := range usedDeprecations {
+			reporter.Record(v, false)
+		}
+		for _, v := range usedAttributes {
+			reporter.Record(v, true)
+		}
+	}
+
+	if len(usedDeprecations) == 0 && len(usedAttributes) == 0 && len(unknownDeprecations) == 0 {
+		return
+	}
+
+	if isDataSource {
+		if read := resource.Read; read != nil {
+			resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+				recordUsed()
+				return read(d, meta)
+			}
+		}
+		return
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		recordUsed()
+
+		for _, v := range unknownDeprecations {
+			if !d.NewValueKnown(v.Field()) {
+				reporter.Record(v, false)
+			}
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(d, meta)
+		}
+
+		return nil
+	}
+}
+
+// findField returns the field at the specified path (omitting any indexes) - or nil if it doesn't exist
+func findField(fields map[string]*schema.Schema, path []string) *schema.Schema {
+	field, ok := fields[path[0]]
+	if !ok {
+		return nil
+	}
+
+	if len(path) == 1 {
+		return field
+	}
+
+	elem, ok := field.Elem.(*schema.Resource)
+	if !ok {
+		return nil
+	}
+
+	return findField(elem.Schema, path[1:])
+}
+
+// isAttribute returns whether the field is Computed-only, and so can't be set in the configuration
+func isAttribute(field *schema.Schema) bool {
+	return field.Computed && !field.Optional && !field.Required
+}
+
+// withRecordedField returns a copy of the fields, where the field at the specified path calls record when it's validated.
+//
+// Since the fields (and the blocks containing them) can be shared between Data Sources and Resources, these are copied
+// rather than being updated in place.
+func withRecordedField(fields map[string]*schema.Schema, path []string, record func()) map[string]*schema.Schema {
+	output := make(map[string]*schema.Schema, len(fields))
+	for k, v := range fields {
+		output[k] = v
+	}
+
+	field := fields[path[0]]
+	if len(path) == 1 {
+		output[path[0]] = withRecording(field, record)
+		return output
+	}
+
+	copied := *field
+	elem := *field.Elem.(*schema.Resource)
+	elem.Schema = withRecordedField(elem.Schema, path[1:], record)
+	copied.Elem = &elem
+	output[path[0]] = &copied
+
+	return output
+}
+
+// withRecording returns a copy of the field which calls record when it's validated. Since validation isn't supported
+// for lists and sets, this is instead applied to the elements within them (or for a block, to each field within it).
+func withRecording(field *schema.Schema, record func()) *schema.Schema {
+	if isAttribute(field) {
+		return field
+	}
+
+	copied := *field
+
+	switch copied.Type {
+	case schema.TypeList, schema.TypeSet:
+		switch elem := copied.Elem.(type) {
+		case *schema.Schema:
+			copied.Elem = withRecording(elem, record)
+
+		case *schema.Resource:
+			copiedElem := *elem
+			copiedElem.Schema = make(map[string]*schema.Schema, len(elem.Schema))
+			for k, v := range elem.Schema {
+				copiedElem.Schema[k] = withRecording(v, record)
+			}
+			copied.Elem = &copiedElem
+		}
+
+	default:
+		validateFunc := copied.ValidateFunc
+		copied.ValidateFunc = func(i interface{}, k string) ([]string, []error) {
+			record()
+
+			if validateFunc != nil {
+				return validateFunc(i, k)
+			}
+
+			return nil, nil
+		}
+	}
+
+	return &copied
+}
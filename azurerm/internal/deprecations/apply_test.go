@@ -0,0 +1,268 @@
+package deprecations
+
+import (
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+// unknownValue is the value used by Terraform for values which are unknown during a plan
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func testRegistry() []Deprecation {
+	return []Deprecation{
+		{
+			Address:      "azurerm_example.old_field",
+			Replacements: []string{"new_field"},
+			RemovedIn:    "3.0",
+		},
+		{
+			Address:   "azurerm_example.old_block",
+			RemovedIn: "3.0",
+		},
+		{
+			Address:      "azurerm_example.some_block.old_list",
+			Replacements: []string{"new_list"},
+		},
+		{
+			Address: "azurerm_example.old_attribute",
+		},
+		{
+			Address:      "azurerm_deprecated",
+			Replacements: []string{"azurerm_example"},
+		},
+	}
+}
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"old_field": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"new_field": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"old_block": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"some_block": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"old_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"old_attribute": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func TestApplyReporting(t *testing.T) {
+	existing := Registry
+	defer func() {
+		Registry = existing
+	}()
+	Registry = testRegistry()
+
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected []string
+	}{
+		{
+			Name: "None",
+			Config: map[string]interface{}{
+				"new_field": "value",
+			},
+			Expected: []string{
+				"azurerm_example.old_attribute",
+			},
+		},
+		{
+			Name: "Field",
+			Config: map[string]interface{}{
+				"old_field": "value",
+			},
+			Expected: []string{
+				"azurerm_example.old_attribute",
+				"azurerm_example.old_field",
+			},
+		},
+		{
+			Name: "Unknown Field",
+			Config: map[string]interface{}{
+				"old_field": unknownValue,
+			},
+			Expected: []string{
+				"azurerm_example.old_attribute",
+				"azurerm_example.old_field",
+			},
+		},
+		{
+			Name: "Block",
+			Config: map[string]interface{}{
+				"old_block": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			Expected: []string{
+				"azurerm_example.old_attribute",
+				"azurerm_example.old_block",
+			},
+		},
+		{
+			Name: "Nested List",
+			Config: map[string]interface{}{
+				"some_block": []interface{}{
+					map[string]interface{}{
+						"old_list": []interface{}{"first", "second"},
+					},
+				},
+			},
+			Expected: []string{
+				"azurerm_example.old_attribute",
+				"azurerm_example.some_block.old_list",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		path := filepath.Join(t.TempDir(), "deprecations.json")
+		resource := testResource()
+		ApplyReporting("azurerm_example", resource, false, NewReporter(path))
+
+		config := terraform.NewResourceConfigRaw(v.Config)
+		if _, errs := resource.Validate(config); len(errs) > 0 {
+			t.Fatalf("validating: %+v", errs)
+		}
+		if _, err := resource.Diff(nil, config, nil); err != nil {
+			t.Fatalf("planning: %+v", err)
+		}
+
+		report, err := ReadReport(path)
+		if err != nil {
+			t.Fatalf("reading the Deprecation Report: %+v", err)
+		}
+
+		actual := make([]string, 0)
+		for _, usage := range report.Usages {
+			actual = append(actual, usage.Address)
+		}
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected the usages %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestApplyReportingDeprecatedResource(t *testing.T) {
+	existing := Registry
+	defer func() {
+		Registry = existing
+	}()
+	Registry = testRegistry()
+
+	path := filepath.Join(t.TempDir(), "deprecations.json")
+	resource := testResource()
+	ApplyReporting("azurerm_deprecated", resource, false, NewReporter(path))
+
+	if _, err := resource.Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{}), nil); err != nil {
+		t.Fatalf("planning: %+v", err)
+	}
+
+	report, err := ReadReport(path)
+	if err != nil {
+		t.Fatalf("reading the Deprecation Report: %+v", err)
+	}
+
+	if len(report.Usages) != 1 {
+		t.Fatalf("Expected a single usage but got %+v", report.Usages)
+	}
+	expected := Usage{
+		Address:      "azurerm_deprecated",
+		Type:         "resource",
+		Name:         "azurerm_deprecated",
+		Replacements: []string{"azurerm_example"},
+		Message:      "The Resource `azurerm_deprecated` has been deprecated in favour of `azurerm_example` and will be removed in a future major version of the Azure Provider.",
+	}
+	if !reflect.DeepEqual(report.Usages[0], expected) {
+		t.Fatalf("Expected the usage %+v but got %+v", expected, report.Usages[0])
+	}
+}
+
+func TestApplyReportingDoesNotUpdateSharedFields(t *testing.T) {
+	existing := Registry
+	defer func() {
+		Registry = existing
+	}()
+	Registry = testRegistry()
+
+	resource := testResource()
+	shared := &schema.Resource{
+		Schema: resource.Schema,
+	}
+	ApplyReporting("azurerm_example", resource, false, NewReporter(filepath.Join(t.TempDir(), "deprecations.json")))
+
+	if shared.Schema["old_field"].ValidateFunc == nil {
+		t.Fatalf("Expected the existing ValidateFunc to be retained")
+	}
+	if resource.Schema["old_field"] == shared.Schema["old_field"] {
+		t.Fatalf("Expected the field `old_field` to be copied rather than updated in place")
+	}
+
+	oldList := func(r *schema.Resource) *schema.Schema {
+		return r.Schema["some_block"].Elem.(*schema.Resource).Schema["old_list"]
+	}
+	if oldList(shared).Elem.(*schema.Schema).ValidateFunc != nil {
+		t.Fatalf("Expected the nested field `some_block.old_list` not to be updated in place")
+	}
+	if oldList(resource).Elem.(*schema.Schema).ValidateFunc == nil {
+		t.Fatalf("Expected the nested field `some_block.old_list` to be validated")
+	}
+
+	names := make([]string, 0)
+	for name := range resource.Schema {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if !reflect.DeepEqual(names, []string{"new_field", "old_attribute", "old_block", "old_field", "some_block"}) {
+		t.Fatalf("Expected the fields to be retained but got %+v", names)
+	}
+}
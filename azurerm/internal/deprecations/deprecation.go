@@ -0,0 +1,122 @@
+package deprecations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Deprecation describes a Data Source, Resource or field which has been deprecated, and what should be used instead
+type Deprecation struct {
+	// Address is the address of the deprecated Data Source/Resource, and the path to the field where applicable
+	// (omitting any indexes) - where (as in Terraform) Data Sources are prefixed with `data.`
+	// e.g. `azurerm_example`, `data.azurerm_example` or `azurerm_example.some_block.some_field`
+	Address string
+
+	// Replacements are the fields (within the same block) or the Data Sources/Resources (prefixed with `azurerm_`)
+	// which should be used instead - which is empty when this is being removed without a replacement
+	Replacements []string
+
+	// RemovedIn is the version of the Azure Provider in which this will be removed e.g. `3.0`
+	// which is empty when this will be removed in a future major version which is yet to be determined
+	RemovedIn string
+
+	// Notes are any additional details about this deprecation e.g. that the field is no longer used by the API
+	Notes string
+}
+
+// IsDataSource returns whether this deprecation applies to a Data Source (or a field within a Data Source)
+func (d Deprecation) IsDataSource() bool {
+	return strings.HasPrefix(d.Address, "data.")
+}
+
+// Name returns the name of the Data Source/Resource which this deprecation applies to e.g. `azurerm_example`
+func (d Deprecation) Name() string {
+	name, _ := d.split()
+	return name
+}
+
+// Field returns the path to the deprecated field e.g. `some_block.some_field` - which is empty when the
+// Data Source/Resource itself is deprecated
+func (d Deprecation) Field() string {
+	_, field := d.split()
+	return field
+}
+
+func (d Deprecation) split() (string, string) {
+	address := strings.TrimPrefix(d.Address, "data.")
+	segments := strings.SplitN(address, ".", 2)
+	if len(segments) == 1 {
+		return segments[0], ""
+	}
+
+	return segments[0], segments[1]
+}
+
+// Message returns a description of this deprecation, including the suggested replacements
+func (d Deprecation) Message() string {
+	subject := fmt.Sprintf("The field `%s`", d.Field())
+	if d.Field() == "" {
+		subject = fmt.Sprintf("The Resource `%s`", d.Name())
+		if d.IsDataSource() {
+			subject = fmt.Sprintf("The Data Source `%s`", d.Name())
+		}
+	}
+
+	version := "a future major version"
+	if d.RemovedIn != "" {
+		version = fmt.Sprintf("version %s", d.RemovedIn)
+	}
+
+	message := fmt.Sprintf("%s has been deprecated and will be removed in %s of the Azure Provider.", subject, version)
+	if len(d.Replacements) > 0 {
+		replacements := make([]string, 0, len(d.Replacements))
+		for _, v := range d.Replacements {
+			replacements = append(replacements, fmt.Sprintf("`%s`", v))
+		}
+		message = fmt.Sprintf("%s has been deprecated in favour of %s and will be removed in %s of the Azure Provider.", subject, strings.Join(replacements, " and "), version)
+	}
+
+	if d.Notes != "" {
+		message = fmt.Sprintf("%s %s", message, d.Notes)
+	}
+
+	return message
+}
+
+// Lookup returns the Deprecation for the specified address (as described in Deprecation.Address) from the Registry,
+// or for the closest enclosing block when the block containing the specified field is deprecated
+func Lookup(address string) *Deprecation {
+	for address != "" {
+		if v, ok := registryByAddress()[address]; ok {
+			return &v
+		}
+
+		index := strings.LastIndex(address, ".")
+		if index == -1 || address[:index] == "data" {
+			break
+		}
+		address = address[:index]
+	}
+
+	return nil
+}
+
+// ForDataSourceOrResource returns the Deprecations in the Registry which apply to the specified Data Source/Resource
+// (either to the Data Source/Resource itself, or to the fields within it)
+func ForDataSourceOrResource(name string, isDataSource bool) []Deprecation {
+	output := make([]Deprecation, 0)
+	for _, v := range Registry {
+		if v.Name() == name && v.IsDataSource() == isDataSource {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func registryByAddress() map[string]Deprecation {
+	output := make(map[string]Deprecation, len(Registry))
+	for _, v := range Registry {
+		output[v.Address] = v
+	}
+	return output
+}
@@ -0,0 +1,129 @@
+package deprecations
+
+import (
+	"testing"
+)
+
+func TestDeprecationAddress(t *testing.T) {
+	testData := []struct {
+		Address      string
+		IsDataSource bool
+		Name         string
+		Field        string
+	}{
+		{
+			Address: "azurerm_example",
+			Name:    "azurerm_example",
+		},
+		{
+			Address:      "data.azurerm_example",
+			IsDataSource: true,
+			Name:         "azurerm_example",
+		},
+		{
+			Address: "azurerm_example.some_field",
+			Name:    "azurerm_example",
+			Field:   "some_field",
+		},
+		{
+			Address:      "data.azurerm_example.some_block.some_field",
+			IsDataSource: true,
+			Name:         "azurerm_example",
+			Field:        "some_block.some_field",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Address)
+
+		d := Deprecation{Address: v.Address}
+		if d.IsDataSource() != v.IsDataSource {
+			t.Fatalf("Expected IsDataSource to be %t but got %t", v.IsDataSource, d.IsDataSource())
+		}
+		if d.Name() != v.Name {
+			t.Fatalf("Expected the Name to be %q but got %q", v.Name, d.Name())
+		}
+		if d.Field() != v.Field {
+			t.Fatalf("Expected the Field to be %q but got %q", v.Field, d.Field())
+		}
+	}
+}
+
+func TestDeprecationMessage(t *testing.T) {
+	testData := []struct {
+		Deprecation Deprecation
+		Expected    string
+	}{
+		{
+			Deprecation: Deprecation{
+				Address:      "azurerm_example",
+				Replacements: []string{"azurerm_other"},
+				RemovedIn:    "3.0",
+			},
+			Expected: "The Resource `azurerm_example` has been deprecated in favour of `azurerm_other` and will be removed in version 3.0 of the Azure Provider.",
+		},
+		{
+			Deprecation: Deprecation{
+				Address: "data.azurerm_example",
+				Notes:   "This is no longer supported.",
+			},
+			Expected: "The Data Source `azurerm_example` has been deprecated and will be removed in a future major version of the Azure Provider. This is no longer supported.",
+		},
+		{
+			Deprecation: Deprecation{
+				Address:      "azurerm_example.some_block.some_field",
+				Replacements: []string{"first_field", "second_field"},
+				RemovedIn:    "3.0",
+			},
+			Expected: "The field `some_block.some_field` has been deprecated in favour of `first_field` and `second_field` and will be removed in version 3.0 of the Azure Provider.",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Deprecation.Address)
+
+		if actual := v.Deprecation.Message(); actual != v.Expected {
+			t.Fatalf("Expected the Message:\n\n%s\n\nbut got:\n\n%s", v.Expected, actual)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	existing := Registry
+	defer func() {
+		Registry = existing
+	}()
+
+	Registry = []Deprecation{
+		{
+			Address: "azurerm_example.some_block",
+		},
+		{
+			Address: "data.azurerm_example",
+		},
+	}
+
+	testData := map[string]string{
+		"azurerm_example":                          "",
+		"azurerm_example.other_field":              "",
+		"azurerm_example.some_block":               "azurerm_example.some_block",
+		"azurerm_example.some_block.nested_field":  "azurerm_example.some_block",
+		"data.azurerm_example":                     "data.azurerm_example",
+		"data.azurerm_example.some_field":          "data.azurerm_example",
+		"data.azurerm_other":                       "",
+		"azurerm_other.some_block.nested_field":    "",
+		"data.azurerm_example.some_block.whatever": "data.azurerm_example",
+	}
+
+	for address, expected := range testData {
+		t.Logf("[DEBUG] Testing %q", address)
+
+		actual := ""
+		if v := Lookup(address); v != nil {
+			actual = v.Address
+		}
+		if actual != expected {
+			t.Fatalf("Expected %q to match %q but got %q", address, expected, actual)
+		}
+	}
+}
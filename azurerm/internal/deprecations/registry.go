@@ -0,0 +1,461 @@
+package deprecations
+
+// Registry contains each of the Data Sources, Resources and fields which have been deprecated within the Provider.
+//
+// Each deprecated Data Source/Resource (via `DeprecationMessage`) and field (via `Deprecated`) must be listed here,
+// which is enforced by the unit tests for the Provider package. Where a block is deprecated, the fields within it
+// don't need to be listed separately.
+var Registry = []Deprecation{
+	{
+		Address:      "azurerm_api_management.security.enable_triple_des_ciphers",
+		Replacements: []string{"triple_des_ciphers_enabled"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:   "azurerm_api_management_diagnostic.enabled",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:      "azurerm_api_management_property",
+		Replacements: []string{"azurerm_api_management_named_value"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_app_service.site_config.ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_app_service.site_config.scm_ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_app_service_environment.user_whitelisted_ip_ranges",
+		Replacements: []string{"allowed_user_ip_cidrs"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_app_service_slot.site_config.ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_app_service_slot.site_config.scm_ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:   "azurerm_cosmosdb_account.geo_location.prefix",
+		RemovedIn: "3.0",
+		Notes:     "This is no longer accepted as an input by the API.",
+	},
+	{
+		Address:      "azurerm_cosmosdb_account.primary_master_key",
+		Replacements: []string{"primary_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_cosmosdb_account.primary_readonly_master_key",
+		Replacements: []string{"primary_readonly_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_cosmosdb_account.secondary_master_key",
+		Replacements: []string{"secondary_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_cosmosdb_account.secondary_readonly_master_key",
+		Replacements: []string{"secondary_readonly_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "data.azurerm_cosmosdb_account.primary_master_key",
+		Replacements: []string{"primary_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "data.azurerm_cosmosdb_account.primary_readonly_master_key",
+		Replacements: []string{"primary_readonly_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "data.azurerm_cosmosdb_account.secondary_master_key",
+		Replacements: []string{"secondary_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "data.azurerm_cosmosdb_account.secondary_readonly_master_key",
+		Replacements: []string{"secondary_readonly_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:   "azurerm_devspace_controller",
+		RemovedIn: "3.0",
+		Notes:     "DevSpace Controllers will be retired on 31 October 2023 and can no longer be provisioned.",
+	},
+	{
+		Address:      "azurerm_eventgrid_event_subscription.eventhub_endpoint",
+		Replacements: []string{"eventhub_endpoint_id"},
+	},
+	{
+		Address:      "azurerm_eventgrid_event_subscription.hybrid_connection_endpoint",
+		Replacements: []string{"hybrid_connection_endpoint_id"},
+	},
+	{
+		Address:   "azurerm_eventgrid_event_subscription.topic_name",
+		RemovedIn: "3.0",
+		Notes:     "This field is read-only in the API and so no longer has any effect.",
+	},
+	{
+		Address:   "azurerm_firewall_policy.dns.network_rule_fqdn_enabled",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:      "azurerm_frontdoor.frontend_endpoint.custom_https_configuration",
+		Replacements: []string{"azurerm_frontdoor_custom_https_configuration"},
+	},
+	{
+		Address:      "azurerm_frontdoor.frontend_endpoint.custom_https_provisioning_enabled",
+		Replacements: []string{"azurerm_frontdoor_custom_https_configuration"},
+	},
+	{
+		Address: "azurerm_frontdoor.location",
+		Notes:   "Front Doors are now always created in the `Global` location.",
+	},
+	{
+		Address: "azurerm_frontdoor_custom_https_configuration.resource_group_name",
+		Notes:   "This field is no longer used.",
+	},
+	{
+		Address:      "azurerm_function_app.site_config.ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_function_app.site_config.scm_ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_function_app.storage_connection_string",
+		Replacements: []string{"storage_account_name", "storage_account_access_key"},
+	},
+	{
+		Address:      "data.azurerm_function_app_host_keys.master_key",
+		Replacements: []string{"primary_key"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_function_app_slot.site_config.ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address:      "azurerm_function_app_slot.site_config.scm_ip_restriction.subnet_id",
+		Replacements: []string{"virtual_network_subnet_id"},
+	},
+	{
+		Address: "azurerm_hdinsight_hadoop_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_hadoop_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address: "azurerm_hdinsight_hbase_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_hbase_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address: "azurerm_hdinsight_interactive_query_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_interactive_query_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address: "azurerm_hdinsight_kafka_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_kafka_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:   "azurerm_hdinsight_ml_services_cluster",
+		RemovedIn: "3.0",
+		Notes:     "HDInsight 3.6 has been retired and ML Services isn't supported in HDInsight 4.0.",
+	},
+	{
+		Address: "azurerm_hdinsight_ml_services_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_ml_services_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:   "azurerm_hdinsight_rserver_cluster",
+		RemovedIn: "3.0",
+		Notes:     "HDInsight 3.6 has been retired and R Server isn't supported in HDInsight 4.0.",
+	},
+	{
+		Address: "azurerm_hdinsight_rserver_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_rserver_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address: "azurerm_hdinsight_spark_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_spark_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:   "azurerm_hdinsight_storm_cluster",
+		RemovedIn: "3.0",
+		Notes:     "HDInsight 3.6 has been retired and Storm isn't supported in HDInsight 4.0.",
+	},
+	{
+		Address: "azurerm_hdinsight_storm_cluster.gateway.enabled",
+		Notes:   "HDInsight no longer supports disabling the Gateway.",
+	},
+	{
+		Address:   "azurerm_hdinsight_storm_cluster.roles.worker_node.min_instance_count",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:   "azurerm_key_vault.soft_delete_enabled",
+		RemovedIn: "3.0",
+		Notes:     "Soft Delete can no longer be disabled and so this is always `true`.",
+	},
+	{
+		Address:   "data.azurerm_key_vault.soft_delete_enabled",
+		RemovedIn: "3.0",
+		Notes:     "Soft Delete can no longer be disabled and so this is always `true`.",
+	},
+	{
+		Address:      "azurerm_kubernetes_cluster.private_link_enabled",
+		Replacements: []string{"private_cluster_enabled"},
+	},
+	{
+		Address:      "azurerm_kusto_database_principal",
+		Replacements: []string{"azurerm_kusto_database_principal_assignment"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_lb_backend_address_pool.backend_address",
+		Replacements: []string{"azurerm_lb_backend_address_pool_address"},
+		RemovedIn:    "3.0",
+		Notes:        "This field is non-functional.",
+	},
+	{
+		Address: "azurerm_lb_backend_address_pool.resource_group_name",
+		Notes:   "This field is no longer used.",
+	},
+	{
+		Address: "azurerm_log_analytics_linked_service.linked_service_name",
+	},
+	{
+		Address:      "azurerm_log_analytics_linked_service.resource_id",
+		Replacements: []string{"read_access_id"},
+	},
+	{
+		Address:      "azurerm_log_analytics_linked_service.workspace_name",
+		Replacements: []string{"workspace_id"},
+	},
+	{
+		Address:   "azurerm_log_analytics_workspace.portal_url",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:   "data.azurerm_log_analytics_workspace.portal_url",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:      "azurerm_management_group.group_id",
+		Replacements: []string{"name"},
+	},
+	{
+		Address:      "data.azurerm_management_group.group_id",
+		Replacements: []string{"name"},
+	},
+	{
+		Address:      "azurerm_mariadb_server.ssl_enforcement",
+		Replacements: []string{"ssl_enforcement_enabled"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_mariadb_server.storage_profile",
+		Replacements: []string{"auto_grow_enabled", "backup_retention_days", "geo_redundant_backup_enabled", "storage_mb"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_mssql_database.extended_auditing_policy",
+		Replacements: []string{"azurerm_mssql_database_extended_auditing_policy"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_mssql_server.extended_auditing_policy",
+		Replacements: []string{"azurerm_mssql_server_extended_auditing_policy"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_mysql_server.ssl_enforcement",
+		Replacements: []string{"ssl_enforcement_enabled"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_mysql_server.storage_profile",
+		Replacements: []string{"auto_grow_enabled", "backup_retention_days", "geo_redundant_backup_enabled", "storage_mb"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_nat_gateway.public_ip_address_ids",
+		Replacements: []string{"azurerm_nat_gateway_public_ip_association"},
+	},
+	{
+		Address:      "azurerm_netapp_volume.export_policy_rule.cifs_enabled",
+		Replacements: []string{"protocols_enabled"},
+	},
+	{
+		Address:      "azurerm_netapp_volume.export_policy_rule.nfsv3_enabled",
+		Replacements: []string{"protocols_enabled"},
+	},
+	{
+		Address:      "azurerm_netapp_volume.export_policy_rule.nfsv4_enabled",
+		Replacements: []string{"protocols_enabled"},
+	},
+	{
+		Address: "azurerm_network_connection_monitor.auto_start",
+		Notes:   "This belongs to v1 of Network Connection Monitors, which has been superseded by v2 in the API.",
+	},
+	{
+		Address:      "azurerm_network_connection_monitor.destination",
+		Replacements: []string{"endpoint", "test_group"},
+		Notes:        "This belongs to v1 of Network Connection Monitors, which has been superseded by v2 in the API.",
+	},
+	{
+		Address:      "azurerm_network_connection_monitor.interval_in_seconds",
+		Replacements: []string{"test_configuration"},
+		Notes:        "This belongs to v1 of Network Connection Monitors, which has been superseded by v2 in the API.",
+	},
+	{
+		Address:      "azurerm_network_connection_monitor.source",
+		Replacements: []string{"endpoint", "test_group"},
+		Notes:        "This belongs to v1 of Network Connection Monitors, which has been superseded by v2 in the API.",
+	},
+	{
+		Address:      "azurerm_packet_capture",
+		Replacements: []string{"azurerm_network_packet_capture"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_policy_definition.management_group_id",
+		Replacements: []string{"management_group_name"},
+	},
+	{
+		Address:      "data.azurerm_policy_definition.management_group_id",
+		Replacements: []string{"management_group_name"},
+	},
+	{
+		Address:      "azurerm_policy_set_definition.management_group_id",
+		Replacements: []string{"management_group_name"},
+	},
+	{
+		Address:      "azurerm_policy_set_definition.policy_definition_reference.parameters",
+		Replacements: []string{"parameter_values"},
+	},
+	{
+		Address:      "azurerm_policy_set_definition.policy_definitions",
+		Replacements: []string{"policy_definition_reference"},
+	},
+	{
+		Address:      "azurerm_postgresql_server.ssl_enforcement",
+		Replacements: []string{"ssl_enforcement_enabled"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_postgresql_server.storage_profile",
+		Replacements: []string{"auto_grow_enabled", "backup_retention_days", "geo_redundant_backup_enabled", "storage_mb"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_sentinel_alert_rule_ms_security_incident.text_whitelist",
+		Replacements: []string{"display_name_filter"},
+	},
+	{
+		Address:   "azurerm_service_fabric_mesh_application",
+		RemovedIn: "3.0",
+		Notes:     "Service Fabric Mesh has been retired.",
+	},
+	{
+		Address:   "azurerm_service_fabric_mesh_local_network",
+		RemovedIn: "3.0",
+		Notes:     "Service Fabric Mesh has been retired.",
+	},
+	{
+		Address:   "azurerm_service_fabric_mesh_secret",
+		RemovedIn: "3.0",
+		Notes:     "Service Fabric Mesh has been retired.",
+	},
+	{
+		Address:   "azurerm_service_fabric_mesh_secret_value",
+		RemovedIn: "3.0",
+		Notes:     "Service Fabric Mesh has been retired.",
+	},
+	{
+		Address:      "azurerm_sql_database.extended_auditing_policy",
+		Replacements: []string{"azurerm_mssql_database_extended_auditing_policy"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_sql_server.extended_auditing_policy",
+		Replacements: []string{"azurerm_mssql_server_extended_auditing_policy"},
+		RemovedIn:    "3.0",
+	},
+	{
+		Address:      "azurerm_subnet.address_prefix",
+		Replacements: []string{"address_prefixes"},
+	},
+	{
+		Address:      "azurerm_template_deployment",
+		Replacements: []string{"azurerm_resource_group_template_deployment"},
+		Notes:        "This will be deprecated in version 3.0 of the Azure Provider, prior to being removed.",
+	},
+	{
+		Address:   "azurerm_virtual_hub_connection.hub_to_vitual_network_traffic_allowed",
+		RemovedIn: "3.0",
+		Notes:     "This property is no longer functional due to a change in the API.",
+	},
+	{
+		Address:   "azurerm_virtual_hub_connection.vitual_network_to_hub_gateways_traffic_allowed",
+		RemovedIn: "3.0",
+		Notes:     "This property is no longer functional due to a change in the API.",
+	},
+	{
+		Address:   "azurerm_virtual_wan.allow_vnet_to_vnet_traffic",
+		RemovedIn: "3.0",
+		Notes:     "This property has been removed from the API.",
+	},
+	{
+		Address:      "azurerm_vpn_server_configuration.radius_server",
+		Replacements: []string{"radius"},
+	},
+}
@@ -0,0 +1,221 @@
+package deprecations
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ReportPathEnvironmentVariable is the Environment Variable used to specify the path to the Deprecation Report,
+// which (when set) records each deprecated Data Source, Resource and field which is used during a plan
+const ReportPathEnvironmentVariable = "ARM_DEPRECATION_REPORT_PATH"
+
+// Report is the Deprecation Report, containing each deprecated Data Source, Resource and field which has been used
+type Report struct {
+	Usages []Usage `json:"usages"`
+}
+
+// Usage is a deprecated Data Source, Resource or field which has been used
+type Usage struct {
+	// Address is the address of the Data Source/Resource and the path to the field where applicable (see Deprecation.Address)
+	Address string `json:"address"`
+
+	// Type is either `data_source` or `resource`
+	Type string `json:"type"`
+
+	// Name is the name of the Data Source/Resource e.g. `azurerm_example`
+	Name string `json:"name"`
+
+	// Field is the path to the deprecated field, which is empty when the Data Source/Resource is deprecated
+	Field string `json:"field,omitempty"`
+
+	// Attribute is whether this field is an Attribute (that is, Computed only) - since references to Attributes
+	// can't be detected by the Provider, these are reported whenever the Data Source/Resource is used
+	Attribute bool `json:"attribute,omitempty"`
+
+	Replacements []string `json:"replacements,omitempty"`
+
+	RemovedIn string `json:"removed_in,omitempty"`
+
+	Message string `json:"message"`
+}
+
+func newUsage(d Deprecation, attribute bool) Usage {
+	usageType := "resource"
+	if d.IsDataSource() {
+		usageType = "data_source"
+	}
+
+	return Usage{
+		Address:      d.Address,
+		Type:         usageType,
+		Name:         d.Name(),
+		Field:        d.Field(),
+		Attribute:    attribute,
+		Replacements: d.Replacements,
+		RemovedIn:    d.RemovedIn,
+		Message:      d.Message(),
+	}
+}
+
+// Reporter records the usage of deprecated Data Sources, Resources and fields into the Deprecation Report
+type Reporter struct {
+	path string
+
+	lock     *sync.Mutex
+	recorded map[string]struct{}
+}
+
+// NewReporter returns a Reporter which records into the Deprecation Report at the specified path
+func NewReporter(path string) *Reporter {
+	return &Reporter{
+		path:     path,
+		lock:     &sync.Mutex{},
+		recorded: make(map[string]struct{}),
+	}
+}
+
+// ReporterFromEnvironment returns a Reporter for the path specified in the `ARM_DEPRECATION_REPORT_PATH`
+// Environment Variable - or nil when the Deprecation Report isn't enabled
+func ReporterFromEnvironment() *Reporter {
+	path := os.Getenv(ReportPathEnvironmentVariable)
+	if path == "" {
+		return nil
+	}
+
+	return NewReporter(path)
+}
+
+// Record records the usage of the specified Deprecation into the Deprecation Report.
+//
+// Since Terraform runs a separate instance of the Provider for each Provider block, the Deprecation Report is
+// merged with the existing Deprecation Report (if any) - and as such the Deprecation Report should be removed
+// prior to running a plan. Failing to update the Deprecation Report is logged rather than failing the plan.
+func (r *Reporter) Record(d Deprecation, attribute bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, exists := r.recorded[d.Address]; exists {
+		return
+	}
+
+	if err := r.write(newUsage(d, attribute)); err != nil {
+		log.Printf("[WARN] Updating the Deprecation Report at %q: %+v", r.path, err)
+		return
+	}
+
+	r.recorded[d.Address] = struct{}{}
+}
+
+func (r *Reporter) write(usage Usage) error {
+	unlock, err := lockFile(r.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	report, err := readReport(r.path)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range report.Usages {
+		if v.Address == usage.Address {
+			return nil
+		}
+	}
+	report.Usages = append(report.Usages, usage)
+	sort.Slice(report.Usages, func(i, j int) bool {
+		return report.Usages[i].Address < report.Usages[j].Address
+	})
+
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing: %+v", err)
+	}
+
+	// the Deprecation Report is replaced atomically, so that it's never partially written
+	temp, err := ioutil.TempFile(filepath.Dir(r.path), fmt.Sprintf(".%s.*", filepath.Base(r.path)))
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %+v", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(append(contents, '\n')); err != nil {
+		temp.Close()
+		return fmt.Errorf("writing temporary file: %+v", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("closing temporary file: %+v", err)
+	}
+
+	if err := os.Rename(temp.Name(), r.path); err != nil {
+		return fmt.Errorf("replacing: %+v", err)
+	}
+
+	return nil
+}
+
+// ReadReport reads the Deprecation Report at the specified path
+func ReadReport(path string) (*Report, error) {
+	report, err := readReport(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &report, nil
+}
+
+func readReport(path string) (Report, error) {
+	report := Report{
+		Usages: make([]Usage, 0),
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return report, nil
+		}
+
+		return report, fmt.Errorf("reading: %+v", err)
+	}
+
+	if err := json.Unmarshal(contents, &report); err != nil {
+		return report, fmt.Errorf("parsing: %+v", err)
+	}
+
+	return report, nil
+}
+
+// lockFile ensures only a single instance of the Provider updates the Deprecation Report at a time, returning
+// a function which releases the lock
+func lockFile(path string) (func(), error) {
+	lockPath := fmt.Sprintf("%s.lock", path)
+
+	timeout := time.Now().Add(30 * time.Second)
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			file.Close()
+			return func() {
+				os.Remove(lockPath)
+			}, nil
+		}
+
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("locking: %+v", err)
+		}
+
+		if time.Now().After(timeout) {
+			return nil, fmt.Errorf("timed out waiting for the lock %q to be released", lockPath)
+		}
+
+		time.Sleep(50 * time.Millisecond)
+	}
+}
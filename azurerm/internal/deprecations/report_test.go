@@ -0,0 +1,103 @@
+package deprecations
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReporterMergesExistingReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deprecations.json")
+
+	// each Provider block uses a separate instance of the Provider, which each update the Deprecation Report
+	first := NewReporter(path)
+	first.Record(Deprecation{Address: "azurerm_example.some_field"}, false)
+	first.Record(Deprecation{Address: "azurerm_example.some_field"}, false)
+
+	second := NewReporter(path)
+	second.Record(Deprecation{Address: "azurerm_example.some_field"}, false)
+	second.Record(Deprecation{Address: "data.azurerm_example"}, false)
+	second.Record(Deprecation{Address: "azurerm_example.other_field"}, true)
+
+	report, err := ReadReport(path)
+	if err != nil {
+		t.Fatalf("reading the Deprecation Report: %+v", err)
+	}
+
+	expected := []Usage{
+		{
+			Address:   "azurerm_example.other_field",
+			Type:      "resource",
+			Name:      "azurerm_example",
+			Field:     "other_field",
+			Attribute: true,
+			Message:   "The field `other_field` has been deprecated and will be removed in a future major version of the Azure Provider.",
+		},
+		{
+			Address: "azurerm_example.some_field",
+			Type:    "resource",
+			Name:    "azurerm_example",
+			Field:   "some_field",
+			Message: "The field `some_field` has been deprecated and will be removed in a future major version of the Azure Provider.",
+		},
+		{
+			Address: "data.azurerm_example",
+			Type:    "data_source",
+			Name:    "azurerm_example",
+			Message: "The Data Source `azurerm_example` has been deprecated and will be removed in a future major version of the Azure Provider.",
+		},
+	}
+	if len(report.Usages) != len(expected) {
+		t.Fatalf("Expected %d usages but got %d: %+v", len(expected), len(report.Usages), report.Usages)
+	}
+	for i, v := range expected {
+		actual := report.Usages[i]
+		if actual.Address != v.Address || actual.Type != v.Type || actual.Name != v.Name || actual.Field != v.Field || actual.Attribute != v.Attribute || actual.Message != v.Message {
+			t.Fatalf("Expected the usage %+v but got %+v", v, actual)
+		}
+	}
+
+	files, err := ioutil.ReadDir(filepath.Dir(path))
+	if err != nil {
+		t.Fatalf("listing the directory: %+v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected only the Deprecation Report to exist but got %d files", len(files))
+	}
+}
+
+func TestReporterInvalidReport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deprecations.json")
+	if err := ioutil.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+
+	// an invalid Deprecation Report is left as-is, rather than failing the plan
+	NewReporter(path).Record(Deprecation{Address: "azurerm_example"}, false)
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+	if string(contents) != "not json" {
+		t.Fatalf("Expected the Deprecation Report not to be updated but got %q", string(contents))
+	}
+
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Fatalf("Expected the lock to be released")
+	}
+}
+
+func TestReporterFromEnvironment(t *testing.T) {
+	os.Unsetenv(ReportPathEnvironmentVariable)
+	if ReporterFromEnvironment() != nil {
+		t.Fatalf("Expected the Deprecation Report to be disabled")
+	}
+
+	os.Setenv(ReportPathEnvironmentVariable, "deprecations.json")
+	defer os.Unsetenv(ReportPathEnvironmentVariable)
+	if ReporterFromEnvironment() == nil {
+		t.Fatalf("Expected the Deprecation Report to be enabled")
+	}
+}
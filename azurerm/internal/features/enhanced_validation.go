@@ -8,8 +8,10 @@ import (
 // EnhancedValidationEnabled returns whether or not the feature for Enhanced Validation is
 // enabled.
 //
-// This functionality calls out to the Azure MetaData Service to cache the list of supported
-// Azure Locations for the specified Endpoint - and then uses that to provide enhanced validation
+// This functionality uses the Location Catalog embedded in the Provider (or, for Azure Environments
+// without a Catalog, calls out to the Azure MetaData Service) to cache the list of supported
+// Azure Locations for the specified Endpoint - and then uses that to provide enhanced validation,
+// including validating the Availability Zones used by a resource at plan time
 //
 // This is enabled by default as of version 2.20 of the Azure Provider, and can be disabled by
 // setting the Environment Variable `ARM_PROVIDER_ENHANCED_VALIDATION` to `false`.
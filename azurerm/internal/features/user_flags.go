@@ -1,11 +1,14 @@
 package features
 
+import "time"
+
 type UserFeatures struct {
 	VirtualMachine         VirtualMachineFeatures
 	VirtualMachineScaleSet VirtualMachineScaleSetFeatures
 	KeyVault               KeyVaultFeatures
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
+	Timeouts               TimeoutsFeatures
 }
 
 type VirtualMachineFeatures struct {
@@ -29,3 +32,20 @@ type NetworkFeatures struct {
 type TemplateDeploymentFeatures struct {
 	DeleteNestedItemsDuringDeletion bool
 }
+
+type TimeoutsFeatures struct {
+	// ResourceTypes is a map of Resource Type (e.g. `azurerm_resource_group`) to the default Timeouts
+	ResourceTypes map[string]OperationTimeouts
+
+	// Services is a map of Service Name (e.g. `Compute`) to the default Timeouts for each
+	// Resource within that Service which doesn't have default Timeouts for it's Resource Type
+	Services map[string]OperationTimeouts
+}
+
+// OperationTimeouts are the default Timeouts for each operation, where zero means unset
+type OperationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
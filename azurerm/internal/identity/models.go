@@ -0,0 +1,48 @@
+package identity
+
+import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+// the models below are the representation of each `identity` block within a Typed Resource, for example:
+//
+// type ExampleResourceModel struct {
+//	 Identity []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
+// }
+
+type ModelSystemAssigned struct {
+	Type        string `tfschema:"type"`
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+}
+
+type ModelSystemAssignedUserAssigned struct {
+	Type        string   `tfschema:"type"`
+	IdentityIds []string `tfschema:"identity_ids"`
+	PrincipalId string   `tfschema:"principal_id"`
+	TenantId    string   `tfschema:"tenant_id"`
+}
+
+type ModelUserAssigned struct {
+	Type        string   `tfschema:"type"`
+	IdentityIds []string `tfschema:"identity_ids"`
+}
+
+// expandIdentityIds returns the User Assigned Identity IDs defined within either a List or a Set
+func expandIdentityIds(input interface{}) []string {
+	output := make([]string, 0)
+
+	var values []interface{}
+	switch v := input.(type) {
+	case *schema.Set:
+		values = v.List()
+	case []interface{}:
+		values = v
+	}
+
+	for _, v := range values {
+		if id, ok := v.(string); ok && id != "" {
+			output = append(output, id)
+		}
+	}
+
+	return output
+}
@@ -1,15 +1,15 @@
 package identity
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 const none = "None"
 const systemAssigned = "SystemAssigned"
-
-// TODO: support UserAssigned & SystemAssigned, UserAssigned
-// const systemAssignedUserAssigned = "SystemAssigned, UserAssigned"
-// const userAssigned = "UserAssigned"
+const systemAssignedUserAssigned = "SystemAssigned, UserAssigned"
+const userAssigned = "UserAssigned"
 
 type ExpandedConfig struct {
 	// Type is the type of User Assigned Identity, either `None`, `SystemAssigned`, `UserAssigned`
@@ -25,3 +25,24 @@ type Identity interface {
 	Flatten(input *ExpandedConfig) []interface{}
 	Schema() *schema.Schema
 }
+
+// normalizeType returns the identity type in the casing used within the Provider, since some
+// APIs return these in a different casing (or `SystemAssigned,UserAssigned` without the space)
+func normalizeType(input string) string {
+	value := strings.Replace(input, " ", "", -1)
+	for _, v := range []string{none, systemAssigned, systemAssignedUserAssigned, userAssigned} {
+		if strings.EqualFold(value, strings.Replace(v, " ", "", -1)) {
+			return v
+		}
+	}
+
+	return input
+}
+
+func coalesce(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
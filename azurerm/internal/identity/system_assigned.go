@@ -22,21 +22,13 @@ func (s SystemAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
 }
 
 func (s SystemAssigned) Flatten(input *ExpandedConfig) []interface{} {
-	if input == nil || input.Type == none {
+	if input == nil || normalizeType(input.Type) == none {
 		return []interface{}{}
 	}
 
-	var coalesce = func(input *string) string {
-		if input == nil {
-			return ""
-		}
-
-		return *input
-	}
-
 	return []interface{}{
 		map[string]interface{}{
-			"type":         input.Type,
+			"type":         normalizeType(input.Type),
 			"principal_id": coalesce(input.PrincipalId),
 			"tenant_id":    coalesce(input.TenantId),
 		},
@@ -69,3 +61,31 @@ func (s SystemAssigned) Schema() *schema.Schema {
 		},
 	}
 }
+
+// ExpandModel expands the typed-sdk representation of the `identity` block
+func (s SystemAssigned) ExpandModel(input []ModelSystemAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	return &ExpandedConfig{
+		Type: systemAssigned,
+	}, nil
+}
+
+// FlattenModel flattens the ExpandedConfig into the typed-sdk representation of the `identity` block
+func (s SystemAssigned) FlattenModel(input *ExpandedConfig) []ModelSystemAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelSystemAssigned{}
+	}
+
+	return []ModelSystemAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			PrincipalId: coalesce(input.PrincipalId),
+			TenantId:    coalesce(input.TenantId),
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package identity
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+)
+
+var _ Identity = SystemAssignedUserAssigned{}
+
+type SystemAssignedUserAssigned struct{}
+
+func (s SystemAssignedUserAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return s.ExpandModel([]ModelSystemAssignedUserAssigned{
+		{
+			Type:        raw["type"].(string),
+			IdentityIds: expandIdentityIds(raw["identity_ids"]),
+		},
+	})
+}
+
+// ExpandModel expands the typed-sdk representation of the `identity` block
+func (s SystemAssignedUserAssigned) ExpandModel(input []ModelSystemAssignedUserAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	identityType := normalizeType(input[0].Type)
+	identityIds := input[0].IdentityIds
+	includesUserAssigned := identityType == userAssigned || identityType == systemAssignedUserAssigned
+
+	if len(identityIds) > 0 && !includesUserAssigned {
+		return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `%s`", userAssigned)
+	}
+
+	// NOTE: `identity_ids` are Optional when `type` includes `UserAssigned` since some
+	// resources (e.g. Virtual Machines) have historically allowed these to be omitted
+	config := ExpandedConfig{
+		Type: identityType,
+	}
+	if len(identityIds) > 0 {
+		config.UserAssignedIdentityIds = &identityIds
+	}
+	return &config, nil
+}
+
+func (s SystemAssignedUserAssigned) Flatten(input *ExpandedConfig) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range s.FlattenModel(input) {
+		output = append(output, map[string]interface{}{
+			"type":         v.Type,
+			"identity_ids": v.IdentityIds,
+			"principal_id": v.PrincipalId,
+			"tenant_id":    v.TenantId,
+		})
+	}
+	return output
+}
+
+// FlattenModel flattens the ExpandedConfig into the typed-sdk representation of the `identity` block
+func (s SystemAssignedUserAssigned) FlattenModel(input *ExpandedConfig) []ModelSystemAssignedUserAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelSystemAssignedUserAssigned{}
+	}
+
+	return []ModelSystemAssignedUserAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			IdentityIds: normalizeUserAssignedIdentityIds(input.UserAssignedIdentityIds),
+			PrincipalId: coalesce(input.PrincipalId),
+			TenantId:    coalesce(input.TenantId),
+		},
+	}
+}
+
+func (s SystemAssignedUserAssigned) Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						systemAssigned,
+						userAssigned,
+						systemAssignedUserAssigned,
+					}, false),
+				},
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.UserAssignedIdentityID,
+					},
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
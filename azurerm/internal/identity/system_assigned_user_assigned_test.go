@@ -0,0 +1,150 @@
+package identity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const testUserAssignedIdentityId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+
+func identityIdsSet(ids ...interface{}) *schema.Set {
+	return schema.NewSet(schema.HashString, ids)
+}
+
+func TestSystemAssignedUserAssignedExpand(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       []interface{}
+		expected    *ExpandedConfig
+		shouldError bool
+	}{
+		{
+			name:  "omitted",
+			input: []interface{}{},
+			expected: &ExpandedConfig{
+				Type: none,
+			},
+		},
+		{
+			name: "system assigned",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssigned,
+					"identity_ids": identityIdsSet(),
+				},
+			},
+			expected: &ExpandedConfig{
+				Type: systemAssigned,
+			},
+		},
+		{
+			name: "system assigned with identity ids",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssigned,
+					"identity_ids": identityIdsSet(testUserAssignedIdentityId),
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "user assigned without identity ids",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         userAssigned,
+					"identity_ids": identityIdsSet(),
+				},
+			},
+			expected: &ExpandedConfig{
+				Type: userAssigned,
+			},
+		},
+		{
+			name: "system assigned, user assigned",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssignedUserAssigned,
+					"identity_ids": identityIdsSet(testUserAssignedIdentityId),
+				},
+			},
+			expected: &ExpandedConfig{
+				Type:                    systemAssignedUserAssigned,
+				UserAssignedIdentityIds: &[]string{testUserAssignedIdentityId},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := SystemAssignedUserAssigned{}.Expand(v.input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestSystemAssignedUserAssignedFlatten(t *testing.T) {
+	principalId := "11111111-1111-1111-1111-111111111111"
+	tenantId := "22222222-2222-2222-2222-222222222222"
+
+	testData := []struct {
+		name     string
+		input    *ExpandedConfig
+		expected []interface{}
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []interface{}{},
+		},
+		{
+			name: "none",
+			input: &ExpandedConfig{
+				Type: "none",
+			},
+			expected: []interface{}{},
+		},
+		{
+			name: "system assigned, user assigned with the api returning a different casing",
+			input: &ExpandedConfig{
+				Type:        "systemassigned,userassigned",
+				PrincipalId: &principalId,
+				TenantId:    &tenantId,
+				UserAssignedIdentityIds: &[]string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userassignedidentities/identity1",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssignedUserAssigned,
+					"identity_ids": []string{testUserAssignedIdentityId},
+					"principal_id": principalId,
+					"tenant_id":    tenantId,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := SystemAssignedUserAssigned{}.Flatten(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
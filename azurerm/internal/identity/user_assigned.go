@@ -0,0 +1,101 @@
+package identity
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+)
+
+var _ Identity = UserAssigned{}
+
+type UserAssigned struct{}
+
+func (u UserAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return u.ExpandModel([]ModelUserAssigned{
+		{
+			Type:        raw["type"].(string),
+			IdentityIds: expandIdentityIds(raw["identity_ids"]),
+		},
+	})
+}
+
+// ExpandModel expands the typed-sdk representation of the `identity` block
+func (u UserAssigned) ExpandModel(input []ModelUserAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	if len(input[0].IdentityIds) == 0 {
+		return nil, fmt.Errorf("at least one `identity_ids` must be specified when `type` is `%s`", userAssigned)
+	}
+
+	identityIds := input[0].IdentityIds
+	return &ExpandedConfig{
+		Type:                    userAssigned,
+		UserAssignedIdentityIds: &identityIds,
+	}, nil
+}
+
+func (u UserAssigned) Flatten(input *ExpandedConfig) []interface{} {
+	output := make([]interface{}, 0)
+	for _, v := range u.FlattenModel(input) {
+		output = append(output, map[string]interface{}{
+			"type":         v.Type,
+			"identity_ids": v.IdentityIds,
+		})
+	}
+	return output
+}
+
+// FlattenModel flattens the ExpandedConfig into the typed-sdk representation of the `identity` block
+func (u UserAssigned) FlattenModel(input *ExpandedConfig) []ModelUserAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelUserAssigned{}
+	}
+
+	return []ModelUserAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			IdentityIds: normalizeUserAssignedIdentityIds(input.UserAssignedIdentityIds),
+		},
+	}
+}
+
+func (u UserAssigned) Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						userAssigned,
+					}, false),
+				},
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.UserAssignedIdentityID,
+					},
+				},
+			},
+		},
+	}
+}
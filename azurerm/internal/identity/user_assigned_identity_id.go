@@ -0,0 +1,26 @@
+package identity
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+)
+
+// normalizeUserAssignedIdentityIds parses each of the User Assigned Identity IDs insensitively, since some APIs
+// return these in a different casing (e.g. `userassignedidentities`) - returning them in the casing used within
+// the Provider. Where an ID can't be parsed it's returned as-is so that it's surfaced rather than being dropped.
+func normalizeUserAssignedIdentityIds(input *[]string) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		id, err := parse.UserAssignedIdentityIDInsensitively(v)
+		if err != nil {
+			output = append(output, v)
+			continue
+		}
+		output = append(output, id.ID())
+	}
+
+	return output
+}
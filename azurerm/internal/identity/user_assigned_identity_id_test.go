@@ -0,0 +1,56 @@
+package identity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeUserAssignedIdentityIds(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    *[]string
+		expected []string
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []string{},
+		},
+		{
+			name:     "empty",
+			input:    &[]string{},
+			expected: []string{},
+		},
+		{
+			name:     "canonical casing",
+			input:    &[]string{testUserAssignedIdentityId},
+			expected: []string{testUserAssignedIdentityId},
+		},
+		{
+			name: "different casing",
+			input: &[]string{
+				"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userassignedidentities/identity1",
+				"/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.ManagedIdentity/USERASSIGNEDIDENTITIES/identity1",
+			},
+			expected: []string{
+				testUserAssignedIdentityId,
+				testUserAssignedIdentityId,
+			},
+		},
+		{
+			// invalid IDs are returned as-is, rather than being dropped
+			name:     "invalid",
+			input:    &[]string{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1"},
+			expected: []string{"/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := normalizeUserAssignedIdentityIds(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
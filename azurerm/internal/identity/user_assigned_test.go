@@ -0,0 +1,135 @@
+package identity
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUserAssignedExpand(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       []interface{}
+		expected    *ExpandedConfig
+		shouldError bool
+	}{
+		{
+			name:  "omitted",
+			input: []interface{}{},
+			expected: &ExpandedConfig{
+				Type: none,
+			},
+		},
+		{
+			name: "user assigned without identity ids",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         userAssigned,
+					"identity_ids": identityIdsSet(),
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "user assigned",
+			input: []interface{}{
+				map[string]interface{}{
+					"type":         userAssigned,
+					"identity_ids": identityIdsSet(testUserAssignedIdentityId),
+				},
+			},
+			expected: &ExpandedConfig{
+				Type:                    userAssigned,
+				UserAssignedIdentityIds: &[]string{testUserAssignedIdentityId},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := UserAssigned{}.Expand(v.input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestUserAssignedExpandModel(t *testing.T) {
+	if _, err := (UserAssigned{}).ExpandModel([]ModelUserAssigned{{Type: userAssigned}}); err == nil {
+		t.Fatalf("expected an error when no `identity_ids` are specified but didn't get one")
+	}
+
+	actual, err := UserAssigned{}.ExpandModel([]ModelUserAssigned{
+		{
+			Type:        userAssigned,
+			IdentityIds: []string{testUserAssignedIdentityId},
+		},
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	expected := &ExpandedConfig{
+		Type:                    userAssigned,
+		UserAssignedIdentityIds: &[]string{testUserAssignedIdentityId},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestUserAssignedFlatten(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    *ExpandedConfig
+		expected []interface{}
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []interface{}{},
+		},
+		{
+			name: "none",
+			input: &ExpandedConfig{
+				Type: "None",
+			},
+			expected: []interface{}{},
+		},
+		{
+			name: "user assigned with the api returning a different casing",
+			input: &ExpandedConfig{
+				Type: "userassigned",
+				UserAssignedIdentityIds: &[]string{
+					"/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userassignedidentities/identity1",
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type":         userAssigned,
+					"identity_ids": []string{testUserAssignedIdentityId},
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := UserAssigned{}.Flatten(v.input)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
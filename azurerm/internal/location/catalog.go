@@ -0,0 +1,77 @@
+package location
+
+import (
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Catalog is a versioned list of the Azure Locations available within an Azure Environment,
+// which is embedded in the Provider so that this information is available offline
+type Catalog struct {
+	// Version is the date this Catalog was last updated, in the format `YYYY-MM-DD`
+	Version string
+
+	// Environment is the name of the Azure Environment this Catalog is for, e.g. `AzurePublicCloud`
+	Environment string
+
+	// Locations is a list of the Azure Locations available within this Azure Environment
+	Locations []CatalogLocation
+}
+
+// CatalogLocation is an Azure Location defined within the Catalog
+type CatalogLocation struct {
+	// Name is the normalized name of this Azure Location, e.g. `westeurope`
+	Name string
+
+	// DisplayName is the human readable name of this Azure Location, e.g. `West Europe`
+	DisplayName string
+
+	// PairedRegion is the normalized name of the Azure Location paired with this one (if any)
+	PairedRegion string
+
+	// Zones is a list of the Availability Zones available within this Azure Location, which is
+	// empty when this Azure Location doesn't support Availability Zones
+	Zones []string
+}
+
+// SupportsAvailabilityZones returns whether Availability Zones are available within this Azure Location
+func (l CatalogLocation) SupportsAvailabilityZones() bool {
+	return len(l.Zones) > 0
+}
+
+// Get returns the Azure Location with the specified name (or display name) - or nil if this
+// Azure Location isn't defined within the Catalog
+func (c Catalog) Get(input string) *CatalogLocation {
+	normalized := Normalize(input)
+	for _, v := range c.Locations {
+		if v.Name == normalized {
+			location := v
+			return &location
+		}
+	}
+
+	return nil
+}
+
+// Names returns the normalized names of all of the Azure Locations within the Catalog
+func (c Catalog) Names() []string {
+	names := make([]string, 0, len(c.Locations))
+	for _, v := range c.Locations {
+		names = append(names, v.Name)
+	}
+	return names
+}
+
+// CatalogForEnvironment returns the Catalog for the specified Azure Environment - or nil if there's
+// no Catalog available for this Azure Environment (for example, when using a custom Metadata Host)
+func CatalogForEnvironment(env azure.Environment) *Catalog {
+	for _, v := range []Catalog{publicCatalog, chinaCatalog, usGovernmentCatalog, germanCatalog} {
+		if strings.EqualFold(v.Environment, env.Name) {
+			catalog := v
+			return &catalog
+		}
+	}
+
+	return nil
+}
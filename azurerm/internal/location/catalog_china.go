@@ -0,0 +1,14 @@
+package location
+
+import "github.com/Azure/go-autorest/autorest/azure"
+
+var chinaCatalog = Catalog{
+	Version:     "2021-02-01",
+	Environment: azure.ChinaCloud.Name,
+	Locations: []CatalogLocation{
+		{Name: "chinaeast", DisplayName: "China East", PairedRegion: "chinanorth"},
+		{Name: "chinaeast2", DisplayName: "China East 2", PairedRegion: "chinanorth2"},
+		{Name: "chinanorth", DisplayName: "China North", PairedRegion: "chinaeast"},
+		{Name: "chinanorth2", DisplayName: "China North 2", PairedRegion: "chinaeast2"},
+	},
+}
@@ -0,0 +1,12 @@
+package location
+
+import "github.com/Azure/go-autorest/autorest/azure"
+
+var germanCatalog = Catalog{
+	Version:     "2021-02-01",
+	Environment: azure.GermanCloud.Name,
+	Locations: []CatalogLocation{
+		{Name: "germanycentral", DisplayName: "Germany Central", PairedRegion: "germanynortheast"},
+		{Name: "germanynortheast", DisplayName: "Germany Northeast", PairedRegion: "germanycentral"},
+	},
+}
@@ -0,0 +1,51 @@
+package location
+
+import "github.com/Azure/go-autorest/autorest/azure"
+
+var publicCatalog = Catalog{
+	Version:     "2021-02-01",
+	Environment: azure.PublicCloud.Name,
+	Locations: []CatalogLocation{
+		{Name: "australiacentral", DisplayName: "Australia Central", PairedRegion: "australiacentral2"},
+		{Name: "australiacentral2", DisplayName: "Australia Central 2", PairedRegion: "australiacentral"},
+		{Name: "australiaeast", DisplayName: "Australia East", PairedRegion: "australiasoutheast", Zones: []string{"1", "2", "3"}},
+		{Name: "australiasoutheast", DisplayName: "Australia Southeast", PairedRegion: "australiaeast"},
+		{Name: "brazilsouth", DisplayName: "Brazil South", PairedRegion: "southcentralus", Zones: []string{"1", "2", "3"}},
+		{Name: "brazilsoutheast", DisplayName: "Brazil Southeast", PairedRegion: "brazilsouth"},
+		{Name: "canadacentral", DisplayName: "Canada Central", PairedRegion: "canadaeast", Zones: []string{"1", "2", "3"}},
+		{Name: "canadaeast", DisplayName: "Canada East", PairedRegion: "canadacentral"},
+		{Name: "centralindia", DisplayName: "Central India", PairedRegion: "southindia", Zones: []string{"1", "2", "3"}},
+		{Name: "centralus", DisplayName: "Central US", PairedRegion: "eastus2", Zones: []string{"1", "2", "3"}},
+		{Name: "eastasia", DisplayName: "East Asia", PairedRegion: "southeastasia", Zones: []string{"1", "2", "3"}},
+		{Name: "eastus", DisplayName: "East US", PairedRegion: "westus", Zones: []string{"1", "2", "3"}},
+		{Name: "eastus2", DisplayName: "East US 2", PairedRegion: "centralus", Zones: []string{"1", "2", "3"}},
+		{Name: "francecentral", DisplayName: "France Central", PairedRegion: "francesouth", Zones: []string{"1", "2", "3"}},
+		{Name: "francesouth", DisplayName: "France South", PairedRegion: "francecentral"},
+		{Name: "germanynorth", DisplayName: "Germany North", PairedRegion: "germanywestcentral"},
+		{Name: "germanywestcentral", DisplayName: "Germany West Central", PairedRegion: "germanynorth", Zones: []string{"1", "2", "3"}},
+		{Name: "japaneast", DisplayName: "Japan East", PairedRegion: "japanwest", Zones: []string{"1", "2", "3"}},
+		{Name: "japanwest", DisplayName: "Japan West", PairedRegion: "japaneast"},
+		{Name: "koreacentral", DisplayName: "Korea Central", PairedRegion: "koreasouth", Zones: []string{"1", "2", "3"}},
+		{Name: "koreasouth", DisplayName: "Korea South", PairedRegion: "koreacentral"},
+		{Name: "northcentralus", DisplayName: "North Central US", PairedRegion: "southcentralus"},
+		{Name: "northeurope", DisplayName: "North Europe", PairedRegion: "westeurope", Zones: []string{"1", "2", "3"}},
+		{Name: "norwayeast", DisplayName: "Norway East", PairedRegion: "norwaywest", Zones: []string{"1", "2", "3"}},
+		{Name: "norwaywest", DisplayName: "Norway West", PairedRegion: "norwayeast"},
+		{Name: "southafricanorth", DisplayName: "South Africa North", PairedRegion: "southafricawest", Zones: []string{"1", "2", "3"}},
+		{Name: "southafricawest", DisplayName: "South Africa West", PairedRegion: "southafricanorth"},
+		{Name: "southcentralus", DisplayName: "South Central US", PairedRegion: "northcentralus", Zones: []string{"1", "2", "3"}},
+		{Name: "southeastasia", DisplayName: "Southeast Asia", PairedRegion: "eastasia", Zones: []string{"1", "2", "3"}},
+		{Name: "southindia", DisplayName: "South India", PairedRegion: "centralindia"},
+		{Name: "switzerlandnorth", DisplayName: "Switzerland North", PairedRegion: "switzerlandwest", Zones: []string{"1", "2", "3"}},
+		{Name: "switzerlandwest", DisplayName: "Switzerland West", PairedRegion: "switzerlandnorth"},
+		{Name: "uaecentral", DisplayName: "UAE Central", PairedRegion: "uaenorth"},
+		{Name: "uaenorth", DisplayName: "UAE North", PairedRegion: "uaecentral", Zones: []string{"1", "2", "3"}},
+		{Name: "uksouth", DisplayName: "UK South", PairedRegion: "ukwest", Zones: []string{"1", "2", "3"}},
+		{Name: "ukwest", DisplayName: "UK West", PairedRegion: "uksouth"},
+		{Name: "westcentralus", DisplayName: "West Central US", PairedRegion: "westus2"},
+		{Name: "westeurope", DisplayName: "West Europe", PairedRegion: "northeurope", Zones: []string{"1", "2", "3"}},
+		{Name: "westindia", DisplayName: "West India", PairedRegion: "southindia"},
+		{Name: "westus", DisplayName: "West US", PairedRegion: "eastus"},
+		{Name: "westus2", DisplayName: "West US 2", PairedRegion: "westcentralus", Zones: []string{"1", "2", "3"}},
+	},
+}
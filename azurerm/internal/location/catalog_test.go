@@ -0,0 +1,109 @@
+package location
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestCatalogForEnvironment(t *testing.T) {
+	testCases := []struct {
+		environment azure.Environment
+		expected    bool
+	}{
+		{
+			environment: azure.PublicCloud,
+			expected:    true,
+		},
+		{
+			environment: azure.ChinaCloud,
+			expected:    true,
+		},
+		{
+			environment: azure.USGovernmentCloud,
+			expected:    true,
+		},
+		{
+			environment: azure.GermanCloud,
+			expected:    true,
+		},
+		{
+			environment: azure.Environment{Name: "AzureStackCloud"},
+			expected:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.environment.Name)
+
+		catalog := CatalogForEnvironment(testCase.environment)
+		if (catalog != nil) != testCase.expected {
+			t.Fatalf("Expected a Catalog to be returned to be %t but got %t", testCase.expected, catalog != nil)
+		}
+	}
+}
+
+func TestCatalogsAreConsistent(t *testing.T) {
+	for _, catalog := range []Catalog{publicCatalog, chinaCatalog, usGovernmentCatalog, germanCatalog} {
+		t.Logf("Testing %q..", catalog.Environment)
+
+		if catalog.Version == "" {
+			t.Fatalf("Expected a Version for the Catalog %q but didn't get one", catalog.Environment)
+		}
+
+		seen := make(map[string]struct{})
+		for _, loc := range catalog.Locations {
+			if _, exists := seen[loc.Name]; exists {
+				t.Fatalf("The Location %q is defined multiple times in the Catalog %q", loc.Name, catalog.Environment)
+			}
+			seen[loc.Name] = struct{}{}
+
+			if loc.Name != Normalize(loc.Name) {
+				t.Fatalf("Expected the Location %q to be normalized", loc.Name)
+			}
+			if Normalize(loc.DisplayName) != loc.Name {
+				t.Fatalf("Expected the Display Name %q to normalize to %q", loc.DisplayName, loc.Name)
+			}
+			if loc.PairedRegion != "" && catalog.Get(loc.PairedRegion) == nil {
+				t.Fatalf("The Paired Region %q for %q was not found in the Catalog %q", loc.PairedRegion, loc.Name, catalog.Environment)
+			}
+		}
+	}
+}
+
+func TestCatalogGet(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "westeurope",
+			expected: "westeurope",
+		},
+		{
+			input:    "West Europe",
+			expected: "westeurope",
+		},
+		{
+			input:    "South East Asia",
+			expected: "southeastasia",
+		},
+		{
+			input:    "chinanorth",
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.input)
+
+		actual := ""
+		if loc := publicCatalog.Get(testCase.input); loc != nil {
+			actual = loc.Name
+		}
+
+		if actual != testCase.expected {
+			t.Fatalf("Expected %q but got %q", testCase.expected, actual)
+		}
+	}
+}
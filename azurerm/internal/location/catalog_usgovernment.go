@@ -0,0 +1,16 @@
+package location
+
+import "github.com/Azure/go-autorest/autorest/azure"
+
+var usGovernmentCatalog = Catalog{
+	Version:     "2021-02-01",
+	Environment: azure.USGovernmentCloud.Name,
+	Locations: []CatalogLocation{
+		{Name: "usdodcentral", DisplayName: "US DoD Central", PairedRegion: "usdodeast"},
+		{Name: "usdodeast", DisplayName: "US DoD East", PairedRegion: "usdodcentral"},
+		{Name: "usgovarizona", DisplayName: "US Gov Arizona", PairedRegion: "usgovtexas"},
+		{Name: "usgoviowa", DisplayName: "US Gov Iowa", PairedRegion: "usgovvirginia"},
+		{Name: "usgovtexas", DisplayName: "US Gov Texas", PairedRegion: "usgovarizona"},
+		{Name: "usgovvirginia", DisplayName: "US Gov Virginia", PairedRegion: "usgovtexas", Zones: []string{"1", "2", "3"}},
+	},
+}
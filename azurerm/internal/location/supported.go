@@ -10,14 +10,56 @@ import (
 // supportedLocations can be (validly) nil - as such this shouldn't be relied on
 var supportedLocations *[]string
 
-// CacheSupportedLocations attempts to retrieve the supported locations from the Azure MetaData Service
-// and caches them, for used in enhanced validation
+// supportedCatalog is the Catalog for the Azure Environment in use, which can be (validly) nil
+// when there's no Catalog available for this Azure Environment
+var supportedCatalog *Catalog
+
+// CacheSupportedLocations caches the supported locations for the Azure Environment, for use in
+// enhanced validation. These are retrieved from the Azure MetaData Service, since the embedded
+// Catalog (used for the Paired Regions and Availability Zones) is a point-in-time snapshot which
+// doesn't contain Locations added since - falling back to the Catalog when the user is offline
 func CacheSupportedLocations(ctx context.Context, env *azure.Environment) {
+	catalog := CatalogForEnvironment(*env)
+	if catalog != nil {
+		log.Printf("[DEBUG] Using version %q of the Location Catalog for %q", catalog.Version, catalog.Environment)
+		supportedCatalog = catalog
+	}
+
 	locs, err := availableAzureLocations(ctx, env)
 	if err != nil {
-		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
-		return
+		if catalog == nil {
+			log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
+			return
+		}
+
+		log.Printf("[DEBUG] error retrieving locations: %s. Falling back to the Locations in the Location Catalog", err)
+		locs = &SupportedLocations{}
+	}
+
+	supportedLocations = mergeLocations(locs.Locations, catalog)
+}
+
+// mergeLocations returns the Locations retrieved from the Azure MetaData Service combined with
+// those in the Catalog (if any), or nil when neither are available
+func mergeLocations(locations *[]string, catalog *Catalog) *[]string {
+	if catalog == nil {
+		return locations
+	}
+
+	output := make([]string, 0)
+	found := make(map[string]struct{})
+	if locations != nil {
+		for _, v := range *locations {
+			output = append(output, v)
+			found[Normalize(v)] = struct{}{}
+		}
+	}
+
+	for _, v := range catalog.Names() {
+		if _, exists := found[Normalize(v)]; !exists {
+			output = append(output, v)
+		}
 	}
 
-	supportedLocations = locs.Locations
+	return &output
 }
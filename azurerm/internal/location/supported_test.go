@@ -0,0 +1,57 @@
+package location
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeLocations(t *testing.T) {
+	catalog := &Catalog{
+		Locations: []CatalogLocation{
+			{Name: "westeurope"},
+			{Name: "northeurope"},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		locations *[]string
+		catalog   *Catalog
+		expected  *[]string
+	}{
+		{
+			name:      "offline without a catalog",
+			locations: nil,
+			catalog:   nil,
+			expected:  nil,
+		},
+		{
+			name:      "online without a catalog",
+			locations: &[]string{"westeurope", "eastus2euap"},
+			catalog:   nil,
+			expected:  &[]string{"westeurope", "eastus2euap"},
+		},
+		{
+			name:      "offline with a catalog",
+			locations: nil,
+			catalog:   catalog,
+			expected:  &[]string{"westeurope", "northeurope"},
+		},
+		{
+			// the Locations from the MetaData Service include those added since the Catalog was updated
+			name:      "online with a catalog",
+			locations: &[]string{"West Europe", "eastus2euap"},
+			catalog:   catalog,
+			expected:  &[]string{"West Europe", "eastus2euap", "northeurope"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.name)
+
+		actual := mergeLocations(testCase.locations, testCase.catalog)
+		if !reflect.DeepEqual(actual, testCase.expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.expected, actual)
+		}
+	}
+}
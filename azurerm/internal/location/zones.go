@@ -0,0 +1,120 @@
+package location
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ApplyZonesValidation updates the Resource to validate that the Availability Zones specified in the
+// `zones` field are available within the Azure Location specified in the `location` field at plan time,
+// which is the case for Resources where both of these fields are defined at the top-level of the Schema
+func ApplyZonesValidation(resource *schema.Resource) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	if v, ok := resource.Schema["location"]; !ok || v.Type != schema.TypeString {
+		return
+	}
+
+	v, ok := resource.Schema["zones"]
+	if !ok || (v.Type != schema.TypeList && v.Type != schema.TypeSet) {
+		return
+	}
+	if elem, ok := v.Elem.(*schema.Schema); !ok || elem.Type != schema.TypeString {
+		return
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return ValidateZones(d, "location", "zones")
+	}
+}
+
+// ValidateZones validates that the Availability Zones specified in the field `zonesKey` are available within
+// the Azure Location specified in the field `locationKey` - using the Catalog for the Azure Environment.
+//
+// NOTE: this is best-effort - when enhanced validation is disabled, there's no Catalog for this Azure Environment
+// or either value isn't known at plan time, this is validated by the Azure API when the resource is provisioned
+func ValidateZones(d *schema.ResourceDiff, locationKey, zonesKey string) error {
+	if !enhancedEnabled || supportedCatalog == nil {
+		return nil
+	}
+
+	// existing resources are only validated when the zones change, to avoid a plan for an existing
+	// resource failing should the Catalog be out of date
+	if d.Id() != "" && !d.HasChange(zonesKey) && !d.HasChange(locationKey) {
+		return nil
+	}
+
+	if !d.NewValueKnown(locationKey) || !d.NewValueKnown(zonesKey) {
+		return nil
+	}
+
+	zones := make([]string, 0)
+	var raw []interface{}
+	switch v := d.Get(zonesKey).(type) {
+	case []interface{}:
+		raw = v
+	case *schema.Set:
+		raw = v.List()
+	}
+	for _, v := range raw {
+		// the individual zones can be unknown, in which case these can't be validated
+		zone, ok := v.(string)
+		if !ok || zone == "" {
+			return nil
+		}
+		zones = append(zones, zone)
+	}
+
+	if err := validateZonesForLocation(*supportedCatalog, d.Get(locationKey).(string), zones); err != nil {
+		return fmt.Errorf("`%s`: %+v", zonesKey, err)
+	}
+
+	return nil
+}
+
+func validateZonesForLocation(catalog Catalog, input string, zones []string) error {
+	if len(zones) == 0 {
+		return nil
+	}
+
+	location := catalog.Get(input)
+	if location == nil {
+		// unknown locations are handled by the validation for the `location` field
+		return nil
+	}
+
+	if !location.SupportsAvailabilityZones() {
+		// the Catalog is a snapshot, so Availability Zones may since have become available in this
+		// location - as such this is left to the Azure API to validate, rather than failing the plan
+		log.Printf("[WARN] Availability Zones are not supported in %q according to the Location Catalog (Version %q), which may be out of date", location.Name, catalog.Version)
+		return nil
+	}
+
+	for _, zone := range zones {
+		found := false
+		for _, v := range location.Zones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("the Availability Zone %q is not available in %q - the available zones are %q", zone, location.Name, strings.Join(location.Zones, ","))
+		}
+	}
+
+	return nil
+}
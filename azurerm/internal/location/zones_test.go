@@ -0,0 +1,124 @@
+package location
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestApplyZonesValidation(t *testing.T) {
+	testCases := []struct {
+		location string
+		zones    []interface{}
+		valid    bool
+	}{
+		{
+			location: "westeurope",
+			zones:    []interface{}{"1"},
+			valid:    true,
+		},
+		{
+			location: "westeurope",
+			zones:    []interface{}{"5"},
+			valid:    false,
+		},
+		{
+			// the Catalog may be out of date, so this is left to the Azure API
+			location: "westcentralus",
+			zones:    []interface{}{"1"},
+			valid:    true,
+		},
+	}
+
+	enhancedEnabled = true
+	supportedCatalog = &publicCatalog
+	defer func() {
+		enhancedEnabled = features.EnhancedValidationEnabled()
+		supportedCatalog = nil
+	}()
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": SchemaWithoutForceNew(),
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+	ApplyZonesValidation(resource)
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q / %q..", testCase.location, testCase.zones)
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"location": testCase.location,
+			"zones":    testCase.zones,
+		})
+		_, err := resource.Diff(nil, config, nil)
+		if valid := err == nil; valid != testCase.valid {
+			t.Fatalf("Expected %t but got %t: %+v", testCase.valid, valid, err)
+		}
+	}
+}
+
+func TestValidateZonesForLocation(t *testing.T) {
+	testCases := []struct {
+		location string
+		zones    []string
+		valid    bool
+	}{
+		{
+			location: "westeurope",
+			zones:    []string{},
+			valid:    true,
+		},
+		{
+			location: "westeurope",
+			zones:    []string{"1", "2", "3"},
+			valid:    true,
+		},
+		{
+			location: "West Europe",
+			zones:    []string{"2"},
+			valid:    true,
+		},
+		{
+			location: "westeurope",
+			zones:    []string{"4"},
+			valid:    false,
+		},
+		{
+			location: "westcentralus",
+			zones:    []string{},
+			valid:    true,
+		},
+		{
+			// the Catalog may be out of date, so this is left to the Azure API
+			location: "westcentralus",
+			zones:    []string{"1"},
+			valid:    true,
+		},
+		{
+			// unknown locations are validated by the `location` field
+			location: "mars",
+			zones:    []string{"1"},
+			valid:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q / %q..", testCase.location, testCase.zones)
+
+		err := validateZonesForLocation(publicCatalog, testCase.location, testCase.zones)
+		if valid := err == nil; valid != testCase.valid {
+			t.Fatalf("Expected %t but got %t: %+v", testCase.valid, valid, err)
+		}
+	}
+}
@@ -1,5 +1,11 @@
 package locks
 
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -7,18 +13,87 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the context is
+// cancelled (or times out) before the lock can be acquired
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	armMutexKV.Lock(NameKey(name, resourceType))
 }
 
+// ByNameWithContext locks the specified name for this resource type, returning an error
+// if the context is cancelled (or times out) before the lock can be acquired
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	return armMutexKV.LockWithContext(ctx, NameKey(name, resourceType))
+}
+
+// MultipleByName locks each of the specified names for this resource type
+// NOTE: the names are locked in a sorted order to avoid deadlocks between callers
 func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	// a background context can't be cancelled, so this can't return an error
+	_ = MultipleByNameWithContext(context.Background(), names, resourceType)
+}
+
+// MultipleByNameWithContext locks each of the specified names for this resource type in a sorted
+// order, returning an error if the context is cancelled (or times out) before all of the locks
+// can be acquired - in which case any locks which were acquired are released
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+
+	return MultipleWithContext(ctx, keys...)
+}
+
+// MultipleWithContext locks each of the specified keys (which can be built using NameKey) in a
+// sorted order, returning an error if the context is cancelled (or times out) before all of the
+// locks can be acquired - in which case any locks which were acquired are released
+//
+// Since every caller acquires the keys in the same order, resources locking the same set of
+// keys (for example a Subnet and it's Virtual Network) can't deadlock one another
+func MultipleWithContext(ctx context.Context, keys ...string) error {
+	return multipleWithContext(ctx, keys, []string{}, callerName(2))
+}
+
+// MultipleMixedWithContext locks each of the specified keys exclusively and each of the specified
+// shared keys in shared mode - in a single sorted order (where a key is specified as both exclusive
+// and shared, it's locked exclusively) - returning an error if the context is cancelled (or times out)
+// before all of the locks can be acquired, in which case any locks which were acquired are released
+//
+// This allows a resource to lock the item it's modifying exclusively, whilst only locking
+// the parent resources it needs to read (for example a Virtual Network) in shared mode
+func MultipleMixedWithContext(ctx context.Context, exclusiveKeys []string, sharedKeys []string) error {
+	return multipleWithContext(ctx, exclusiveKeys, sharedKeys, callerName(2))
+}
 
-	for _, name := range newSlice {
-		ByName(name, resourceType)
+func multipleWithContext(ctx context.Context, exclusiveKeys []string, sharedKeys []string, caller string) error {
+	keys, shared := sortedMixedKeys(exclusiveKeys, sharedKeys)
+
+	for i, key := range keys {
+		if err := armMutexKV.lockWithCaller(ctx, key, shared[key], caller); err != nil {
+			for j := i - 1; j >= 0; j-- {
+				unlockKey(keys[j], shared[keys[j]])
+			}
+			return err
+		}
 	}
+
+	return nil
+}
+
+// NameKey returns the key used to lock the specified name for this resource type
+func NameKey(name string, resourceType string) string {
+	return fmt.Sprintf("%s.%s", resourceType, name)
+}
+
+// Dump returns a human-readable representation of each lock which is currently
+// held, who's holding it and who's waiting on it - which is useful for debugging
+func Dump() string {
+	return armMutexKV.Dump()
 }
 
 func UnlockByID(id string) {
@@ -26,14 +101,110 @@ func UnlockByID(id string) {
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(NameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+
+	UnlockMultiple(keys...)
+}
+
+// UnlockMultiple unlocks each of the specified keys, in the reverse order they were locked
+func UnlockMultiple(keys ...string) {
+	UnlockMultipleMixed(keys, []string{})
+}
+
+// UnlockMultipleMixed unlocks each of the specified exclusive and shared keys
+// in the reverse order they were locked by MultipleMixedWithContext
+func UnlockMultipleMixed(exclusiveKeys []string, sharedKeys []string) {
+	keys, shared := sortedMixedKeys(exclusiveKeys, sharedKeys)
+	for i := len(keys) - 1; i >= 0; i-- {
+		unlockKey(keys[i], shared[keys[i]])
+	}
+}
 
-	for _, name := range newSlice {
-		UnlockByName(name, resourceType)
+// ByIDShared locks the specified ID in shared mode - allowing other callers to also lock this
+// ID in shared mode, whilst blocking callers locking this ID exclusively (e.g. using ByID)
+func ByIDShared(id string) {
+	armMutexKV.RLock(id)
+}
+
+// ByIDSharedWithContext locks the specified ID in shared mode, returning an error if the
+// context is cancelled (or times out) before the lock can be acquired
+func ByIDSharedWithContext(ctx context.Context, id string) error {
+	return armMutexKV.RLockWithContext(ctx, id)
+}
+
+// ByNameShared locks the specified name for this resource type in shared mode - allowing other
+// callers to also lock this in shared mode, whilst blocking callers locking this exclusively
+func ByNameShared(name string, resourceType string) {
+	armMutexKV.RLock(NameKey(name, resourceType))
+}
+
+// ByNameSharedWithContext locks the specified name for this resource type in shared mode, returning
+// an error if the context is cancelled (or times out) before the lock can be acquired
+func ByNameSharedWithContext(ctx context.Context, name string, resourceType string) error {
+	return armMutexKV.RLockWithContext(ctx, NameKey(name, resourceType))
+}
+
+// MultipleByNameShared locks each of the specified names for this resource type in shared mode
+// NOTE: the names are locked in a sorted order to avoid deadlocks between callers
+func MultipleByNameShared(names *[]string, resourceType string) {
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+
+	// a background context can't be cancelled, so this can't return an error
+	_ = multipleWithContext(context.Background(), []string{}, keys, callerName(2))
+}
+
+func UnlockByIDShared(id string) {
+	armMutexKV.RUnlock(id)
+}
+
+func UnlockByNameShared(name string, resourceType string) {
+	armMutexKV.RUnlock(NameKey(name, resourceType))
+}
+
+func UnlockMultipleByNameShared(names *[]string, resourceType string) {
+	keys := make([]string, 0)
+	for _, name := range *names {
+		keys = append(keys, NameKey(name, resourceType))
+	}
+
+	UnlockMultipleMixed([]string{}, keys)
+}
+
+func unlockKey(key string, shared bool) {
+	if shared {
+		armMutexKV.RUnlock(key)
+		return
+	}
+
+	armMutexKV.Unlock(key)
+}
+
+// sortedMixedKeys returns the unique, sorted list of exclusive and shared keys - alongside
+// a map of key to whether it should be locked in shared mode
+func sortedMixedKeys(exclusiveKeys []string, sharedKeys []string) ([]string, map[string]bool) {
+	shared := make(map[string]bool)
+	for _, key := range sharedKeys {
+		shared[key] = true
+	}
+	for _, key := range exclusiveKeys {
+		shared[key] = false
 	}
+
+	keys := make([]string, 0)
+	for key := range shared {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys, shared
 }
@@ -0,0 +1,244 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLockWithContextTimesOut(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("first")
+	defer kv.Unlock("first")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := kv.LockWithContext(ctx, "first")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `"first" held by`) {
+		t.Fatalf("expected the error to contain the current holders but got: %+v", err)
+	}
+}
+
+func TestLockWithContextAcquiresOnceReleased(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("first")
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		kv.Unlock("first")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := kv.LockWithContext(ctx, "first"); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	kv.Unlock("first")
+}
+
+func TestMultipleWithContextAvoidsDeadlocks(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// these are requested in the opposite order, which would deadlock if acquired as specified
+	orders := [][]string{
+		{NameKey("vnet1", "azurerm_virtual_network"), NameKey("subnet1", "azurerm_subnet")},
+		{NameKey("subnet1", "azurerm_subnet"), NameKey("vnet1", "azurerm_virtual_network")},
+	}
+
+	wg := sync.WaitGroup{}
+	errs := make(chan error, 100)
+	for i := 0; i < 50; i++ {
+		for _, keys := range orders {
+			wg.Add(1)
+			go func(keys []string) {
+				defer wg.Done()
+				if err := MultipleWithContext(ctx, keys...); err != nil {
+					errs <- err
+					return
+				}
+				UnlockMultiple(keys...)
+			}(keys)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+}
+
+func TestMultipleWithContextReleasesOnError(t *testing.T) {
+	ByName("second", "azurerm_example")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	keys := []string{NameKey("first", "azurerm_example"), NameKey("second", "azurerm_example")}
+	if err := MultipleWithContext(ctx, keys...); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	UnlockByName("second", "azurerm_example")
+
+	// the first key should have been released, so these can now all be acquired
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel2()
+	if err := MultipleWithContext(ctx2, keys...); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	UnlockMultiple(keys...)
+}
+
+func TestDump(t *testing.T) {
+	kv := NewMutexKV()
+	if actual := kv.Dump(); actual != "  (none)" {
+		t.Fatalf("expected no locks but got %q", actual)
+	}
+
+	kv.Lock("first")
+	actual := kv.Dump()
+	if !strings.Contains(actual, `"first" held by `) {
+		t.Fatalf("expected the dump to contain the holder of the lock but got %q", actual)
+	}
+
+	kv.Unlock("first")
+	if actual := kv.Dump(); actual != "  (none)" {
+		t.Fatalf("expected no locks but got %q", actual)
+	}
+}
+
+func TestRLockAllowsMultipleSharedHolders(t *testing.T) {
+	kv := NewMutexKV()
+	kv.RLock("first")
+	defer kv.RUnlock("first")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := kv.RLockWithContext(ctx, "first"); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	kv.RUnlock("first")
+}
+
+func TestRLockWithContextTimesOutWhilstLockedExclusively(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("first")
+	defer kv.Unlock("first")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := kv.RLockWithContext(ctx, "first"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestLockWithContextTimesOutWhilstLockedShared(t *testing.T) {
+	kv := NewMutexKV()
+	kv.RLock("first")
+	defer kv.RUnlock("first")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := kv.LockWithContext(ctx, "first")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), "(shared, for") {
+		t.Fatalf("expected the error to contain the shared holders but got: %+v", err)
+	}
+}
+
+func TestRLockWaitsForExclusiveWaiters(t *testing.T) {
+	kv := NewMutexKV()
+	kv.RLock("first")
+
+	exclusiveAcquired := make(chan struct{})
+	go func() {
+		kv.Lock("first")
+		close(exclusiveAcquired)
+	}()
+
+	// wait for the exclusive caller to start waiting
+	for !strings.Contains(kv.Dump(), "waited on by") {
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// new shared callers should give way to the exclusive caller, rather than starving it
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := kv.RLockWithContext(ctx, "first"); err == nil {
+		t.Fatalf("expected an error since an exclusive caller is waiting but didn't get one")
+	}
+
+	kv.RUnlock("first")
+	select {
+	case <-exclusiveAcquired:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for the exclusive lock to be acquired")
+	}
+	kv.Unlock("first")
+
+	if actual := kv.Dump(); actual != "  (none)" {
+		t.Fatalf("expected no locks but got %q", actual)
+	}
+}
+
+func TestMultipleMixedWithContext(t *testing.T) {
+	vnet := NameKey("vnet2", "azurerm_virtual_network")
+	subnets := []string{
+		NameKey("subnet1", "azurerm_subnet"),
+		NameKey("subnet2", "azurerm_subnet"),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// each subnet can be locked exclusively alongside the shared virtual network
+	for _, subnet := range subnets {
+		if err := MultipleMixedWithContext(ctx, []string{subnet}, []string{vnet}); err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+
+	// but the virtual network can't be locked exclusively until they're released
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer shortCancel()
+	if err := MultipleWithContext(shortCtx, vnet); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	for _, subnet := range subnets {
+		UnlockMultipleMixed([]string{subnet}, []string{vnet})
+	}
+
+	if err := MultipleWithContext(ctx, vnet); err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+	UnlockMultiple(vnet)
+}
+
+func TestSortedMixedKeys(t *testing.T) {
+	keys, shared := sortedMixedKeys([]string{"c", "a"}, []string{"b", "a", "b"})
+
+	expected := []string{"a", "b", "c"}
+	if strings.Join(keys, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected %+v but got %+v", expected, keys)
+	}
+	if shared["a"] {
+		t.Fatalf("expected `a` to be locked exclusively since it was specified as both exclusive and shared")
+	}
+	if !shared["b"] || shared["c"] {
+		t.Fatalf("expected only `b` to be locked shared but got %+v", shared)
+	}
+}
@@ -1,40 +1,309 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"runtime"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
+// stallInterval is the interval at which a warning (containing the current
+// holders of each lock) is logged whilst waiting to acquire a lock
+var stallInterval = 1 * time.Minute
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+//
+// Each key can either be held exclusively by a single caller, or shared by multiple
+// callers (for example by resources which only need to read the parent resource)
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*keyMutex
+}
+
+// keyMutex is a reader/writer mutex which can be acquired whilst honouring a context, and
+// which tracks who currently holds (and who's waiting on) it for debugging purposes
+//
+// all of the fields are protected by the lock on the mutexKV
+type keyMutex struct {
+	// exclusiveHolder is the caller currently holding this exclusively, if any
+	exclusiveHolder string
+
+	// sharedHolders is the number of times each caller currently holds this shared
+	sharedHolders map[string]int
+
+	// since is when this was (first) acquired by the current holders
+	since time.Time
+
+	// released is closed (and then replaced) each time this is released, to wake any waiters
+	released chan struct{}
+
+	// exclusiveWaiters is the number of callers waiting to acquire this exclusively - new shared
+	// holders wait whilst this is non-zero so that exclusive callers can't be starved
+	exclusiveWaiters int
+
+	// waiters is the number of times each caller is waiting to acquire this
+	waiters map[string]int
+}
+
+func (k *keyMutex) sharedCount() int {
+	count := 0
+	for _, v := range k.sharedHolders {
+		count += v
+	}
+	return count
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	// a background context can't be cancelled, so this can't return an error
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context
+// is cancelled (or times out) prior to the lock being acquired. Caller is responsible for
+// calling Unlock for the same key when (and only when) this returns no error
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	return m.lockWithCaller(ctx, key, false, callerName(3))
+}
+
+// RLock locks the mutex for the given key in shared mode. Caller is responsible for
+// calling RUnlock for the same key
+func (m *mutexKV) RLock(key string) {
+	// a background context can't be cancelled, so this can't return an error
+	_ = m.RLockWithContext(context.Background(), key)
+}
+
+// RLockWithContext locks the mutex for the given key in shared mode, returning an error if
+// the context is cancelled (or times out) prior to the lock being acquired. Caller is responsible
+// for calling RUnlock for the same key when (and only when) this returns no error
+func (m *mutexKV) RLockWithContext(ctx context.Context, key string) error {
+	return m.lockWithCaller(ctx, key, true, callerName(3))
+}
+
+func (m *mutexKV) lockWithCaller(ctx context.Context, key string, shared bool, caller string) error {
+	mode := "exclusively"
+	if shared {
+		mode = "shared"
+	}
+	log.Printf("[DEBUG] Locking %q (%s)", key, mode)
+
+	started := time.Now()
+	var ticker *time.Ticker
+	waiting := false
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+
+	for {
+		released, acquired := m.tryLock(key, shared, caller, !waiting)
+		if acquired {
+			log.Printf("[DEBUG] Locked %q (%s)", key, mode)
+			return nil
+		}
+
+		if !waiting {
+			waiting = true
+			ticker = time.NewTicker(stallInterval)
+		}
+
+		select {
+		case <-released:
+			// try again
+
+		case <-ticker.C:
+			log.Printf("[WARN] Still waiting to lock %q (%s) after %s - the current locks are:\n%s", key, mode, time.Since(started).Round(time.Second), m.Dump())
+
+		case <-ctx.Done():
+			m.stopWaiting(key, shared, caller)
+			return fmt.Errorf("waiting to lock %q (%s) after %s: %+v\n\nThe current locks are:\n%s", key, mode, time.Since(started).Round(time.Second), ctx.Err(), m.Dump())
+		}
+	}
+}
+
+// tryLock attempts to acquire the lock - returning whether it was acquired and, if not, a channel
+// which is closed when the lock is next released. When startWaiting is true and the lock isn't
+// acquired, the caller is registered as a waiter
+func (m *mutexKV) tryLock(key string, shared bool, caller string, startWaiting bool) (<-chan struct{}, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex := m.getLocked(key)
+	wasWaiting := !startWaiting
+
+	available := mutex.exclusiveHolder == "" && mutex.sharedCount() == 0
+	if shared {
+		// shared callers give way to anyone waiting to acquire this exclusively
+		available = mutex.exclusiveHolder == "" && mutex.exclusiveWaiters == 0
+	}
+
+	if available {
+		if wasWaiting {
+			m.removeWaiter(mutex, shared, caller)
+		}
+		if mutex.exclusiveHolder == "" && mutex.sharedCount() == 0 {
+			mutex.since = time.Now()
+		}
+		if shared {
+			mutex.sharedHolders[caller]++
+		} else {
+			mutex.exclusiveHolder = caller
+		}
+		return nil, true
+	}
+
+	if startWaiting {
+		mutex.waiters[caller]++
+		if !shared {
+			mutex.exclusiveWaiters++
+		}
+	}
+
+	return mutex.released, false
+}
+
+// stopWaiting removes the caller as a waiter when it's given up waiting
+func (m *mutexKV) stopWaiting(key string, shared bool, caller string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex := m.getLocked(key)
+	m.removeWaiter(mutex, shared, caller)
+
+	// this may have been blocking shared callers, so wake them up
+	m.notifyLocked(mutex)
+}
+
+func (m *mutexKV) removeWaiter(mutex *keyMutex, shared bool, caller string) {
+	mutex.waiters[caller]--
+	if mutex.waiters[caller] <= 0 {
+		delete(mutex.waiters, caller)
+	}
+	if !shared {
+		mutex.exclusiveWaiters--
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
-	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
-	log.Printf("[DEBUG] Unlocked %q", key)
+	log.Printf("[DEBUG] Unlocking %q (exclusively)", key)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex := m.getLocked(key)
+	if mutex.exclusiveHolder == "" {
+		panic(fmt.Sprintf("unlock of key %q which isn't locked exclusively", key))
+	}
+	mutex.exclusiveHolder = ""
+	m.notifyLocked(mutex)
+
+	log.Printf("[DEBUG] Unlocked %q (exclusively)", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// RUnlock unlocks the mutex for the given key in shared mode. Caller must have called RLock for the same key first
+func (m *mutexKV) RUnlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q (shared)", key)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	mutex := m.getLocked(key)
+	if mutex.sharedCount() == 0 {
+		panic(fmt.Sprintf("unlock of key %q which isn't locked in shared mode", key))
+	}
+
+	// the function releasing this is usually the function which acquired it, but
+	// where it isn't, release the first holder instead so that the count is correct
+	caller := callerName(3)
+	if _, ok := mutex.sharedHolders[caller]; !ok {
+		holders := make([]string, 0)
+		for k := range mutex.sharedHolders {
+			holders = append(holders, k)
+		}
+		sort.Strings(holders)
+		caller = holders[0]
+	}
+	mutex.sharedHolders[caller]--
+	if mutex.sharedHolders[caller] <= 0 {
+		delete(mutex.sharedHolders, caller)
+	}
+	m.notifyLocked(mutex)
+
+	log.Printf("[DEBUG] Unlocked %q (shared)", key)
+}
+
+// notifyLocked wakes up anything waiting on the mutex - the lock on the mutexKV must be held
+func (m *mutexKV) notifyLocked(mutex *keyMutex) {
+	close(mutex.released)
+	mutex.released = make(chan struct{})
+}
+
+// Dump returns a human-readable representation of each key which is currently
+// locked, who's holding it and who's waiting on it - ordered by key
+func (m *mutexKV) Dump() string {
 	m.lock.Lock()
 	defer m.lock.Unlock()
+
+	keys := make([]string, 0)
+	for key, mutex := range m.store {
+		if mutex.exclusiveHolder != "" || mutex.sharedCount() > 0 || len(mutex.waiters) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	if len(keys) == 0 {
+		return "  (none)"
+	}
+
+	lines := make([]string, 0)
+	for _, key := range keys {
+		mutex := m.store[key]
+		holder := "(nobody)"
+		if mutex.exclusiveHolder != "" {
+			holder = fmt.Sprintf("%s (exclusively, for %s)", mutex.exclusiveHolder, time.Since(mutex.since).Round(time.Second))
+		} else if mutex.sharedCount() > 0 {
+			holders := make([]string, 0)
+			for k, v := range mutex.sharedHolders {
+				holders = append(holders, fmt.Sprintf("%s (x%d)", k, v))
+			}
+			sort.Strings(holders)
+			holder = fmt.Sprintf("%s (shared, for %s)", strings.Join(holders, ", "), time.Since(mutex.since).Round(time.Second))
+		}
+		lines = append(lines, fmt.Sprintf("  %q held by %s", key, holder))
+
+		waiters := make([]string, 0)
+		for waiter, count := range mutex.waiters {
+			waiters = append(waiters, fmt.Sprintf("%s (x%d)", waiter, count))
+		}
+		sort.Strings(waiters)
+		for _, waiter := range waiters {
+			lines = append(lines, fmt.Sprintf("    waited on by %s", waiter))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status
+// the lock on the mutexKV must be held
+func (m *mutexKV) getLocked(key string) *keyMutex {
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = &keyMutex{
+			sharedHolders: make(map[string]int),
+			released:      make(chan struct{}),
+			waiters:       make(map[string]int),
+		}
 		m.store[key] = mutex
 	}
 	return mutex
@@ -43,6 +312,29 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*keyMutex),
 	}
 }
+
+// callerName returns the name of the function outside of this package which is acquiring
+// (or releasing) the lock, skipping the specified number of stack frames
+func callerName(skip int) string {
+	pcs := make([]uintptr, 10)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/internal/locks.") {
+			function := frame.Function
+			if idx := strings.LastIndex(function, "/"); idx != -1 {
+				function = function[idx+1:]
+			}
+			return function
+		}
+		if !more {
+			break
+		}
+	}
+
+	return "(unknown)"
+}
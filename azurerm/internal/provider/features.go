@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -55,6 +60,42 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 			},
 		},
 
+		"timeouts": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: schemaFeaturesTimeouts(map[string]*schema.Schema{
+								"type": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							}),
+						},
+					},
+					"service": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: schemaFeaturesTimeouts(map[string]*schema.Schema{
+								"name": {
+									Type:         schema.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							}),
+						},
+					},
+				},
+			},
+		},
+
 		"virtual_machine": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -111,6 +152,35 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 	}
 }
 
+func schemaFeaturesTimeouts(input map[string]*schema.Schema) map[string]*schema.Schema {
+	for _, operation := range []string{"create", "read", "update", "delete"} {
+		input[operation] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+		}
+	}
+
+	return input
+}
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration (e.g. `30m` or `2h`): %+v", k, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%q must be greater than zero", k)}
+	}
+
+	return nil, nil
+}
+
 func expandFeatures(input []interface{}) features.UserFeatures {
 	// these are the defaults if omitted from the config
 	features := features.Default()
@@ -154,6 +224,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["timeouts"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			timeoutsRaw := items[0].(map[string]interface{})
+			features.Timeouts.ResourceTypes = expandFeaturesTimeouts(timeoutsRaw["resource"], "type")
+			features.Timeouts.Services = expandFeaturesTimeouts(timeoutsRaw["service"], "name")
+		}
+	}
+
 	if raw, ok := val["virtual_machine"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -179,3 +258,49 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 
 	return features
 }
+
+func expandFeaturesTimeouts(input interface{}, keyName string) map[string]features.OperationTimeouts {
+	output := make(map[string]features.OperationTimeouts)
+
+	items, ok := input.([]interface{})
+	if !ok {
+		return output
+	}
+
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+
+		raw := item.(map[string]interface{})
+		key := raw[keyName].(string)
+
+		// these are validated in the schema, so parsing errors can be ignored
+		parse := func(operation string) time.Duration {
+			v, ok := raw[operation].(string)
+			if !ok || v == "" {
+				return 0
+			}
+
+			duration, _ := time.ParseDuration(v)
+			return duration
+		}
+
+		output[key] = features.OperationTimeouts{
+			Create: parse("create"),
+			Read:   parse("read"),
+			Update: parse("update"),
+			Delete: parse("delete"),
+		}
+	}
+
+	return output
+}
+
+func timeoutsFromMeta(meta interface{}) features.TimeoutsFeatures {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.Features.Timeouts
+	}
+
+	return features.TimeoutsFeatures{}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
@@ -487,3 +488,77 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesTimeouts(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: features.TimeoutsFeatures{},
+			},
+		},
+		{
+			Name: "Resource Types and Services",
+			Input: []interface{}{
+				map[string]interface{}{
+					"timeouts": []interface{}{
+						map[string]interface{}{
+							"resource": []interface{}{
+								map[string]interface{}{
+									"type":   "azurerm_kubernetes_cluster",
+									"create": "3h",
+									"read":   "",
+									"update": "",
+									"delete": "2h",
+								},
+							},
+							"service": []interface{}{
+								map[string]interface{}{
+									"name":   "Compute",
+									"create": "90m",
+									"read":   "10m",
+									"update": "",
+									"delete": "",
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				Timeouts: features.TimeoutsFeatures{
+					ResourceTypes: map[string]features.OperationTimeouts{
+						"azurerm_kubernetes_cluster": {
+							Create: 3 * time.Hour,
+							Delete: 2 * time.Hour,
+						},
+					},
+					Services: map[string]features.OperationTimeouts{
+						"Compute": {
+							Create: 90 * time.Minute,
+							Read:   10 * time.Minute,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.Timeouts, testCase.Expected.Timeouts) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected.Timeouts, result.Timeouts)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 
@@ -13,20 +14,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/deprecations"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func AzureProvider() terraform.ResourceProvider {
-	return azureProvider(false)
+	return azureProvider(false, nil)
 }
 
 func TestAzureProvider() terraform.ResourceProvider {
-	return azureProvider(true)
+	return azureProvider(true, nil)
 }
 
-func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
+// TestAzureProviderWithRecorder returns an instance of the Provider for use in tests, which
+// records (or replays) the requests sent to Azure using the specified Recorder
+func TestAzureProviderWithRecorder(recorder *recording.Recorder) terraform.ResourceProvider {
+	return azureProvider(true, recorder)
+}
+
+func azureProvider(supportLegacyTestSuite bool, recorder *recording.Recorder) terraform.ResourceProvider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
 		if os.Getenv("TF_LOG") == "" {
@@ -43,8 +56,16 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 	dataSources := make(map[string]*schema.Resource)
 	resources := make(map[string]*schema.Resource)
 
+	// used to determine the default timeouts for each service from the features block
+	serviceNames := make(map[string]string)
+
+	// used to determine the Resource Providers used by each Resource and Data Source
+	serviceResourceProviders := make(map[string][]string)
+
 	// first handle the typed services
 	for _, service := range SupportedTypedServices() {
+		serviceResourceProviders[service.Name()] = service.ResourceProviders()
+
 		debugLog("[DEBUG] Registering Data Sources for %q..", service.Name())
 		for _, ds := range service.DataSources() {
 			key := ds.ResourceType()
@@ -59,6 +80,7 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 			}
 
 			dataSources[key] = dataSource
+			serviceNames[key] = service.Name()
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
@@ -74,11 +96,14 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", key, err))
 			}
 			resources[key] = resource
+			serviceNames[key] = service.Name()
 		}
 	}
 
 	// then handle the untyped services
 	for _, service := range SupportedUntypedServices() {
+		serviceResourceProviders[service.Name()] = service.ResourceProviders()
+
 		debugLog("[DEBUG] Registering Data Sources for %q..", service.Name())
 		for k, v := range service.SupportedDataSources() {
 			if existing := dataSources[k]; existing != nil {
@@ -86,6 +111,7 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 			}
 
 			dataSources[k] = v
+			serviceNames[k] = service.Name()
 		}
 
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
@@ -95,6 +121,43 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 			}
 
 			resources[k] = v
+			serviceNames[k] = service.Name()
+		}
+	}
+
+	// the `default_tags` defined in the Provider block are merged into the Tags for each Resource supporting them
+	// NOTE: the ignored Tags need to be applied first, so that these aren't treated as configured on the Resource
+	for _, resource := range resources {
+		tags.ApplyIgnoredTags(resource, ignoredTagsFromMeta, existingTagsFromMeta)
+		tags.ApplyDefaultTags(resource, defaultTagsFromMeta, ignoredTagsFromMeta)
+
+		// the `zones` are validated against the Availability Zones available in the `location` at plan time
+		location.ApplyZonesValidation(resource)
+	}
+
+	// the default timeouts for each Resource and Data Source can be overridden in the features block
+	for name, resource := range resources {
+		timeouts.ApplyDefaults(resource, name, serviceNames[name], timeoutsFromMeta)
+	}
+	for name, dataSource := range dataSources {
+		timeouts.ApplyDefaults(dataSource, name, serviceNames[name], timeoutsFromMeta)
+	}
+
+	// the Resource Providers used by each Resource and Data Source can be registered on-demand
+	for name, resource := range resources {
+		resourceproviders.ApplyRegistration(resource, serviceResourceProviders[serviceNames[name]], ensureResourceProvidersRegisteredFromMeta)
+	}
+	for name, dataSource := range dataSources {
+		resourceproviders.ApplyRegistration(dataSource, serviceResourceProviders[serviceNames[name]], ensureResourceProvidersRegisteredFromMeta)
+	}
+
+	// the deprecated Data Sources, Resources and fields used during a plan can be recorded into the Deprecation Report
+	if reporter := deprecations.ReporterFromEnvironment(); reporter != nil {
+		for name, resource := range resources {
+			deprecations.ApplyReporting(name, resource, false, reporter)
+		}
+		for name, dataSource := range dataSources {
+			deprecations.ApplyReporting(name, dataSource, true, reporter)
 		}
 	}
 
@@ -212,8 +275,36 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			// Retry specific fields
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", common.DefaultRetryPolicy().MaxRetries),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of times a request to Azure which is throttled (or fails with a transient error) should be retried by the Provider, which replaces the retries made by the Azure SDK when greater than 0.",
+			},
+
+			"max_retry_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_BACKOFF", common.DefaultRetryPolicy().MaxBackoff.String()),
+				ValidateFunc: validateDuration,
+				Description:  "The maximum delay between retries of a request to Azure, for example `60s`.",
+			},
+
+			"honor_retry_after": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_HONOR_RETRY_AFTER", common.DefaultRetryPolicy().HonorRetryAfter),
+				Description: "Should the delay specified in the `Retry-After` header returned from Azure be used when retrying a request?",
+			},
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -222,6 +313,34 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"resource_provider_registration_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATION_MODE", string(resourceproviders.RegistrationModeAll)),
+				ValidateFunc: validation.StringInSlice(resourceproviders.PossibleRegistrationModes(), false),
+				Description:  "Which Resource Providers should the AzureRM Provider automatically register? Possible values are `all`, `none` and `used`.",
+			},
+
+			"resource_providers_to_register": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Resource Providers which should always be registered, regardless of the `resource_provider_registration_mode`.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"resource_providers_to_skip": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Resource Providers which should never be automatically registered.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -244,12 +363,12 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
-	p.ConfigureFunc = providerConfigure(p)
+	p.ConfigureFunc = providerConfigure(p, recorder)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+func providerConfigure(p *schema.Provider, recorder *recording.Recorder) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -294,9 +413,22 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			ClientSecretDocsLink: "https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/service_principal_client_secret",
 		}
 
-		config, err := builder.Build()
-		if err != nil {
-			return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+		var config *authentication.Config
+		if recorder != nil && recorder.Mode() == recording.ModeReplay {
+			// requests aren't sent to Azure when replaying, so there's no need to authenticate
+			config = &authentication.Config{
+				ClientID:       recording.ClientIDPlaceholder,
+				SubscriptionID: recording.SubscriptionIDPlaceholder,
+				TenantID:       recording.TenantIDPlaceholder,
+				Environment:    builder.Environment,
+				MetadataHost:   builder.MetadataHost,
+			}
+		} else {
+			var err error
+			config, err = builder.Build()
+			if err != nil {
+				return nil, fmt.Errorf("Error building AzureRM Client: %s", err)
+			}
 		}
 
 		terraformVersion := p.TerraformVersion
@@ -306,15 +438,31 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		userFeatures := expandFeatures(d.Get("features").([]interface{}))
+
+		// this is validated in the schema, so the error can be ignored
+		maxRetryBackoff, _ := time.ParseDuration(d.Get("max_retry_backoff").(string))
+		retryPolicy := common.DefaultRetryPolicy()
+		retryPolicy.MaxRetries = d.Get("max_retries").(int)
+		retryPolicy.MaxBackoff = maxRetryBackoff
+		retryPolicy.HonorRetryAfter = d.Get("honor_retry_after").(bool)
+
+		registrationMode := resourceproviders.RegistrationMode(d.Get("resource_provider_registration_mode").(string))
+		if d.Get("skip_provider_registration").(bool) {
+			registrationMode = resourceproviders.RegistrationModeNone
+		}
+		skipProviderRegistration := registrationMode != resourceproviders.RegistrationModeAll
+
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
+			Recorder:                    recorder,
+			RetryPolicy:                 retryPolicy,
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
-			Features:                    expandFeatures(d.Get("features").([]interface{})),
+			Features:                    userFeatures,
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
 		}
 		client, err := clients.Build(p.StopContext(), clientBuilder)
@@ -323,6 +471,8 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		client.StopContext = p.StopContext()
+		client.DefaultTags = expandDefaultTags(d.Get("default_tags").([]interface{}))
+		client.IgnoredTags = expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -330,20 +480,22 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil
 		}
 
-		if !skipProviderRegistration {
-			// List all the available providers and their registration state to avoid unnecessary requests.
-			ctx := client.StopContext
-			providerList, err := client.Resource.ProvidersClient.List(ctx, nil, "")
-			if err != nil {
-				return nil, fmt.Errorf("Unable to list provider registration status, it is possible that this is due to invalid "+
-					"credentials or the service principal does not have permission to use the Resource Manager API, Azure "+
-					"error: %s", err)
+		resourceProvidersToSkip := expandResourceProviders(d.Get("resource_providers_to_skip").(*schema.Set).List())
+		resourceProvidersToRegister := expandResourceProviders(d.Get("resource_providers_to_register").(*schema.Set).List())
+		registrar := resourceproviders.NewRegistrar(client.Resource.ProvidersClient, resourceProvidersToSkip)
+		switch registrationMode {
+		case resourceproviders.RegistrationModeAll:
+			for resourceProvider := range resourceproviders.Required() {
+				resourceProvidersToRegister = append(resourceProvidersToRegister, resourceProvider)
 			}
 
-			availableResourceProviders := providerList.Values()
-			requiredResourceProviders := resourceproviders.Required()
+		case resourceproviders.RegistrationModeUsed:
+			// the Resource Providers used by each Resource and Data Source are registered the first time it's used
+			client.ResourceProviderRegistrar = registrar
+		}
 
-			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
+		if len(resourceProvidersToRegister) > 0 {
+			if err := registrar.EnsureRegistered(client.StopContext, resourceProvidersToRegister); err != nil {
 				return nil, fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
 			}
 		}
@@ -357,8 +509,12 @@ const resourceProviderRegistrationErrorFmt = `Error ensuring Resource Providers
 Terraform automatically attempts to register the Resource Providers it supports to
 ensure it's able to provision resources.
 
-If you don't have permission to register Resource Providers you may wish to use the
-"skip_provider_registration" flag in the Provider block to disable this functionality.
+If you don't have permission to register some Resource Providers you may wish to add these
+to the "resource_providers_to_skip" list in the Provider block, or to set the
+"resource_provider_registration_mode" field in the Provider block to "used" to only register
+the Resource Providers used in the configuration. Alternatively this functionality can be
+disabled by setting "resource_provider_registration_mode" to "none" (or by using the
+"skip_provider_registration" flag in the Provider block).
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
 to provision a resource from a Resource Provider which is unregistered, then the errors
@@ -369,7 +525,7 @@ may appear misleading - for example:
 Could indicate either that the Resource Provider "Microsoft.Foo" requires registration,
 but this could also indicate that this Azure Region doesn't support this API version.
 
-More information on the "skip_provider_registration" flag can be found here:
-https://www.terraform.io/docs/providers/azurerm/index.html#skip_provider_registration
+More information on Resource Provider Registration can be found here:
+https://www.terraform.io/docs/providers/azurerm/index.html#resource_provider_registration_mode
 
 Original Error: %s`
@@ -2,10 +2,14 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/deprecations"
 )
 
 func TestProvider(t *testing.T) {
@@ -89,3 +93,84 @@ func TestResourcesSupportCustomTimeouts(t *testing.T) {
 func TestProvider_impl(t *testing.T) {
 	_ = AzureProvider()
 }
+
+func TestProviderWithDeprecationReport(t *testing.T) {
+	os.Setenv(deprecations.ReportPathEnvironmentVariable, filepath.Join(t.TempDir(), "deprecations.json"))
+	defer os.Unsetenv(deprecations.ReportPathEnvironmentVariable)
+
+	if err := TestAzureProvider().(*schema.Provider).InternalValidate(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestDeprecationsAreRegistered(t *testing.T) {
+	provider := TestAzureProvider().(*schema.Provider)
+
+	var walk func(address string, fields map[string]*schema.Schema)
+	walk = func(address string, fields map[string]*schema.Schema) {
+		for name, field := range fields {
+			fieldAddress := fmt.Sprintf("%s.%s", address, name)
+			if field.Deprecated != "" && deprecations.Lookup(fieldAddress) == nil {
+				t.Errorf("the field %q is deprecated but isn't listed in the Deprecations Registry", fieldAddress)
+			}
+
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				walk(fieldAddress, elem.Schema)
+			}
+		}
+	}
+
+	for name, dataSource := range provider.DataSourcesMap {
+		address := fmt.Sprintf("data.%s", name)
+		if dataSource.DeprecationMessage != "" && deprecations.Lookup(address) == nil {
+			t.Errorf("the Data Source %q is deprecated but isn't listed in the Deprecations Registry", name)
+		}
+		walk(address, dataSource.Schema)
+	}
+
+	for name, resource := range provider.ResourcesMap {
+		if resource.DeprecationMessage != "" && deprecations.Lookup(name) == nil {
+			t.Errorf("the Resource %q is deprecated but isn't listed in the Deprecations Registry", name)
+		}
+		walk(name, resource.Schema)
+	}
+}
+
+func TestDeprecationsRegistryIsValid(t *testing.T) {
+	provider := TestAzureProvider().(*schema.Provider)
+
+	seen := make(map[string]struct{})
+	for _, v := range deprecations.Registry {
+		if _, exists := seen[v.Address]; exists {
+			t.Errorf("the Deprecations Registry contains a duplicate entry for %q", v.Address)
+		}
+		seen[v.Address] = struct{}{}
+
+		resource, ok := provider.ResourcesMap[v.Name()]
+		if v.IsDataSource() {
+			resource, ok = provider.DataSourcesMap[v.Name()]
+		}
+		if !ok {
+			t.Errorf("the Deprecations Registry contains %q which doesn't exist", v.Address)
+			continue
+		}
+
+		if v.Field() == "" {
+			continue
+		}
+
+		fields := resource.Schema
+		for _, name := range strings.Split(v.Field(), ".") {
+			field, ok := fields[name]
+			if !ok {
+				t.Errorf("the Deprecations Registry contains %q which doesn't exist", v.Address)
+				break
+			}
+
+			fields = nil
+			if elem, ok := field.Elem.(*schema.Resource); ok {
+				fields = elem.Schema
+			}
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+func expandResourceProviders(input []interface{}) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		output = append(output, v.(string))
+	}
+	return output
+}
+
+func ensureResourceProvidersRegisteredFromMeta(meta interface{}, resourceProviders []string) error {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || client.ResourceProviderRegistrar == nil || len(resourceProviders) == 0 {
+		return nil
+	}
+
+	return client.ResourceProviderRegistrar.EnsureRegistered(client.StopContext, resourceProviders)
+}
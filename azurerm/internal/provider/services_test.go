@@ -1,8 +1,12 @@
 package provider
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 )
 
@@ -31,3 +35,62 @@ func TestTypedResourcesContainValidModelObjects(t *testing.T) {
 		}
 	}
 }
+
+func TestTypedDataSourcesContainModelObjectsMatchingSchema(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.DataSources() {
+			t.Logf("- DataSources %q..", resource.ResourceType())
+			obj := resource.ModelObject()
+			resourceSchema := combinedTypedSchema(resource.Arguments(), resource.Attributes())
+			if err := sdk.ValidateModelObjectMatchesSchema(&obj, resourceSchema); err != nil {
+				t.Fatalf("validating model matches schema: %+v", err)
+			}
+		}
+	}
+}
+
+func TestTypedResourcesContainModelObjectsMatchingSchema(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.Resources() {
+			t.Logf("- Resource %q..", resource.ResourceType())
+			obj := resource.ModelObject()
+			resourceSchema := combinedTypedSchema(resource.Arguments(), resource.Attributes())
+			if err := sdk.ValidateModelObjectMatchesSchema(&obj, resourceSchema); err != nil {
+				t.Fatalf("validating model matches schema: %+v", err)
+			}
+		}
+	}
+}
+
+func combinedTypedSchema(arguments map[string]*schema.Schema, attributes map[string]*schema.Schema) map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	for k, v := range arguments {
+		out[k] = v
+	}
+	for k, v := range attributes {
+		out[k] = v
+	}
+	return out
+}
+
+func TestUntypedServicesResourceProvidersUseConsistentCasing(t *testing.T) {
+	// the Resource Providers used by each Service should use the same casing as the list of
+	// Resource Providers registered when using the `all` registration mode
+	required := resourceproviders.Required()
+	for _, service := range SupportedUntypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resourceProvider := range service.ResourceProviders() {
+			if resourceProvider == "" {
+				t.Fatalf("the Resource Providers for the Service %q contain an empty value", service.Name())
+			}
+
+			for v := range required {
+				if v != resourceProvider && strings.EqualFold(v, resourceProvider) {
+					t.Fatalf("the Resource Provider %q should use the casing %q", resourceProvider, v)
+				}
+			}
+		}
+	}
+}
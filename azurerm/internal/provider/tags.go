@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func schemaDefaultTags() *schema.Schema {
+	// NOTE: this is a block (rather than a map) so that further options can be added in the future
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Tags which should be assigned to every Resource supporting Tags.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:         schema.TypeMap,
+					Required:     true,
+					ValidateFunc: tags.Validate,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandDefaultTags(input []interface{}) map[string]string {
+	output := make(map[string]string)
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	raw := input[0].(map[string]interface{})
+	for k, v := range tags.Expand(raw["tags"].(map[string]interface{})) {
+		output[k] = *v
+	}
+	return output
+}
+
+func defaultTagsFromMeta(meta interface{}) map[string]string {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.DefaultTags
+	}
+
+	return map[string]string{}
+}
+
+func schemaIgnoreTags() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "The Tags which are managed outside of Terraform, which should be ignored.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+				"key_prefixes": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandIgnoreTags(input []interface{}) tags.IgnoreConfig {
+	if len(input) == 0 || input[0] == nil {
+		return tags.IgnoreConfig{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return tags.IgnoreConfig{
+		Keys:        *utils.ExpandStringSlice(raw["keys"].([]interface{})),
+		KeyPrefixes: *utils.ExpandStringSlice(raw["key_prefixes"].([]interface{})),
+	}
+}
+
+func ignoredTagsFromMeta(meta interface{}) tags.IgnoreConfig {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.IgnoredTags
+	}
+
+	return tags.IgnoreConfig{}
+}
+
+func existingTagsFromMeta(meta interface{}, id string) (map[string]string, error) {
+	// the Tags API only supports Resource Manager IDs (for example not Key Vault Secrets)
+	if !strings.HasPrefix(strings.ToLower(id), "/subscriptions/") {
+		return nil, fmt.Errorf("the Tags API doesn't support the ID %q", id)
+	}
+
+	client := meta.(*clients.Client).Resource.TagsClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	resp, err := client.GetAtScope(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Tags for %q: %+v", id, err)
+	}
+
+	output := make(map[string]string)
+	if props := resp.Properties; props != nil && props.Tags != nil {
+		for k, v := range props.Tags {
+			if v != nil {
+				output[k] = *v
+			}
+		}
+	}
+	return output, nil
+}
@@ -0,0 +1,84 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// Cassette contains the requests and responses recorded for a single Test
+type Cassette struct {
+	// Variables are values stored alongside the recorded requests, for example randomly generated names
+	Variables map[string]string `json:"variables"`
+
+	// Interactions are the recorded requests and responses, in the order they were sent
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single recorded request and the response returned from Azure
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+func (r Response) httpResponse(req *http.Request) *http.Response {
+	headers := http.Header{}
+	for k, v := range r.Headers {
+		headers.Set(k, v)
+	}
+
+	// there's no need to wait between requests when replaying (e.g. when polling)
+	if headers.Get("Retry-After") != "" {
+		headers.Set("Retry-After", "0")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+func loadCassette(path string) (*Cassette, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("no recording exists at %q - record this test by setting `%s` to %q", path, ModeEnvironmentVariable, ModeRecord)
+		}
+
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	var cassette Cassette
+	if err := json.NewDecoder(bytes.NewReader(contents)).Decode(&cassette); err != nil {
+		return nil, fmt.Errorf("deserializing %q: %+v", path, err)
+	}
+
+	if cassette.Variables == nil {
+		cassette.Variables = make(map[string]string)
+	}
+
+	return &cassette, nil
+}
@@ -0,0 +1,302 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Mode is the mode a Recorder operates in
+type Mode string
+
+const (
+	// ModeDisabled means requests are sent to Azure and not recorded
+	ModeDisabled Mode = ""
+
+	// ModeRecord means requests are sent to Azure and the (scrubbed) requests
+	// and responses are recorded, before being saved into a Cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay means requests are never sent to Azure, instead the responses
+	// are replayed from the requests and responses recorded in a Cassette
+	ModeReplay Mode = "replay"
+)
+
+// ModeEnvironmentVariable is the Environment Variable used to specify the Mode for Acceptance Tests
+const ModeEnvironmentVariable = "ARM_TEST_RECORDING_MODE"
+
+// ModeFromEnvironment returns the Mode specified in the `ARM_TEST_RECORDING_MODE` Environment Variable
+func ModeFromEnvironment() (Mode, error) {
+	v := Mode(strings.ToLower(os.Getenv(ModeEnvironmentVariable)))
+	switch v {
+	case ModeDisabled, ModeRecord, ModeReplay:
+		return v, nil
+	}
+
+	return ModeDisabled, fmt.Errorf("%q must be either %q or %q but got %q", ModeEnvironmentVariable, ModeRecord, ModeReplay, v)
+}
+
+const (
+	// SubscriptionIDPlaceholder replaces the Subscription ID in recorded requests and responses
+	SubscriptionIDPlaceholder = "00000000-0000-0000-0000-000000000000"
+
+	// TenantIDPlaceholder replaces the Tenant ID in recorded requests and responses
+	TenantIDPlaceholder = "11111111-1111-1111-1111-111111111111"
+
+	// ClientIDPlaceholder replaces the Client ID in recorded requests and responses
+	ClientIDPlaceholder = "22222222-2222-2222-2222-222222222222"
+
+	// ObjectIDPlaceholder replaces the Object ID of the authenticated Principal in recorded requests and responses
+	ObjectIDPlaceholder = "33333333-3333-3333-3333-333333333333"
+
+	scrubbedPlaceholder = "SCRUBBED"
+)
+
+// the response headers which are recorded - all other headers are discarded
+var recordedResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"Location",
+	"Retry-After",
+}
+
+var (
+	// tokens and secrets which can appear within the JSON bodies of requests and responses
+	scrubbedJsonFields = regexp.MustCompile(`"(access_token|refresh_token|id_token|client_secret|password)"(\s*):(\s*)"[^"]*"`)
+
+	// signatures which can appear within the query string of a URL (for example within a SAS Token)
+	scrubbedQueryValues = regexp.MustCompile(`([?&](sig|code)=)[^&"\s]+`)
+)
+
+// Recorder records the requests sent to (and responses received from) Azure into a Cassette,
+// which can then be replayed to run the same requests without connecting to Azure
+type Recorder struct {
+	cassette *Cassette
+	mode     Mode
+	path     string
+
+	lock      sync.Mutex
+	scrubbed  map[string]string
+	replayed  map[int]bool
+	sequences map[string]int
+}
+
+// NewRecorder returns a Recorder using the Cassette at the specified path - which is
+// loaded when replaying, and saved (when Save is called) when recording
+func NewRecorder(mode Mode, path string) (*Recorder, error) {
+	recorder := &Recorder{
+		cassette: &Cassette{
+			Interactions: make([]Interaction, 0),
+			Variables:    make(map[string]string),
+		},
+		mode:      mode,
+		path:      path,
+		scrubbed:  make(map[string]string),
+		replayed:  make(map[int]bool),
+		sequences: make(map[string]int),
+	}
+
+	if mode == ModeReplay {
+		cassette, err := loadCassette(path)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = cassette
+	}
+
+	return recorder, nil
+}
+
+// CassettePath returns the path to the Cassette for the specified Test within the `testdata` directory
+func CassettePath(testName string) string {
+	fileName := regexp.MustCompile(`[^a-zA-Z0-9_\-]+`).ReplaceAllString(testName, "_")
+	return filepath.Join("testdata", "recordings", fmt.Sprintf("%s.json", fileName))
+}
+
+// Mode returns the Mode this Recorder is operating in
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Scrub replaces all occurrences of the specified value with the replacement when recording,
+// for example to remove the Subscription ID from the requests and responses
+func (r *Recorder) Scrub(value string, replacement string) {
+	if value == "" || value == replacement {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.scrubbed[value] = replacement
+}
+
+// Variable returns the value of a Variable stored alongside the recorded requests, if it exists
+func (r *Recorder) Variable(key string) (string, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v, ok := r.cassette.Variables[key]
+	return v, ok
+}
+
+// SetVariable stores a Variable alongside the recorded requests, for example any randomly
+// generated names - so that the same values can be used when the requests are replayed
+func (r *Recorder) SetVariable(key string, value string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cassette.Variables[key] = value
+}
+
+// Save saves the recorded requests and responses into the Cassette
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("creating directory for %q: %+v", r.path, err)
+	}
+
+	contents, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing Cassette: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(r.path, contents, 0644); err != nil {
+		return fmt.Errorf("writing Cassette to %q: %+v", r.path, err)
+	}
+
+	return nil
+}
+
+// SendDecorator returns a SendDecorator which records (or replays) each request
+func (r *Recorder) SendDecorator() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		switch r.mode {
+		case ModeRecord:
+			return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+				return r.record(s, req)
+			})
+
+		case ModeReplay:
+			return autorest.SenderFunc(r.replay)
+		}
+
+		return s
+	}
+}
+
+func (r *Recorder) record(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	requestBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	resp, err := s.Do(req)
+	if err != nil || resp == nil {
+		// connectivity issues aren't recorded since these can't be replayed
+		return resp, err
+	}
+
+	responseBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body: %+v", err)
+	}
+
+	headers := make(map[string]string)
+	for _, header := range recordedResponseHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			headers[header] = v
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for k, v := range headers {
+		headers[k] = r.scrubLocked(v)
+	}
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    r.scrubLocked(req.URL.String()),
+			Body:   r.scrubLocked(requestBody),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       r.scrubLocked(responseBody),
+		},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if _, err := readBody(&req.Body); err != nil {
+		return nil, fmt.Errorf("reading request body: %+v", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	url := r.scrubLocked(req.URL.String())
+	key := fmt.Sprintf("%s %s", req.Method, url)
+
+	// requests can be sent in parallel, but requests to the same URL are sent in order
+	// (e.g. when polling) - so this replays the next unused Interaction for this URL
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || interaction.Request.Method != req.Method || interaction.Request.URL != url {
+			continue
+		}
+
+		r.replayed[i] = true
+		r.sequences[key]++
+		log.Printf("[DEBUG] Replaying recorded response %d for %s", r.sequences[key], key)
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	return nil, fmt.Errorf("no recorded response was found for %s in %q - re-record this test by setting `%s` to %q", key, r.path, ModeEnvironmentVariable, ModeRecord)
+}
+
+// scrubLocked removes tokens, secrets and scrubbed values from the input - the lock must be held
+func (r *Recorder) scrubLocked(input string) string {
+	output := scrubbedJsonFields.ReplaceAllString(input, fmt.Sprintf(`"$1"$2:$3"%s"`, scrubbedPlaceholder))
+	output = scrubbedQueryValues.ReplaceAllString(output, fmt.Sprintf("${1}%s", scrubbedPlaceholder))
+	for value, replacement := range r.scrubbed {
+		output = strings.Replace(output, value, replacement, -1)
+	}
+	return output
+}
+
+// readBody reads the body, replacing it so that it can be read again
+func readBody(body *io.ReadCloser) (string, error) {
+	if body == nil || *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	contents, err := ioutil.ReadAll(*body)
+	if err != nil {
+		return "", err
+	}
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(contents))
+
+	return string(contents), nil
+}
@@ -0,0 +1,170 @@
+package recording
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testSubscriptionId = "12345678-1234-9876-4563-123456789012"
+
+func send(t *testing.T, recorder *Recorder, method, url, body string) (int, string, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(&http.Client{}, recorder.SendDecorator())
+	resp, err := sender.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+
+	return resp.StatusCode, string(contents), nil
+}
+
+func TestRecordThenReplay(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Ms-Request-Id", "abc123")
+		if i == 1 {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"access_token": "secret", "id": "/subscriptions/` + testSubscriptionId + `/resourceGroups/example"}`))
+			return
+		}
+
+		w.Write([]byte(`{"provisioningState": "Succeeded"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "recordings", "TestRecordThenReplay.json")
+	url := server.URL + "/subscriptions/" + testSubscriptionId + "/resourceGroups/example"
+
+	recorder, err := NewRecorder(ModeRecord, path)
+	if err != nil {
+		t.Fatalf("building recorder: %+v", err)
+	}
+	recorder.Scrub(testSubscriptionId, SubscriptionIDPlaceholder)
+	recorder.SetVariable("random_integer", "123")
+
+	if _, _, err := send(t, recorder, http.MethodPut, url, `{"location": "westeurope"}`); err != nil {
+		t.Fatalf("sending first request: %+v", err)
+	}
+	if _, _, err := send(t, recorder, http.MethodGet, url, ""); err != nil {
+		t.Fatalf("sending second request: %+v", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("saving: %+v", err)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	for _, unexpected := range []string{testSubscriptionId, "secret", "X-Ms-Request-Id"} {
+		if strings.Contains(string(contents), unexpected) {
+			t.Fatalf("expected %q to be removed from the cassette but got:\n%s", unexpected, string(contents))
+		}
+	}
+
+	server.Close()
+	replayer, err := NewRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+	replayer.Scrub(testSubscriptionId, SubscriptionIDPlaceholder)
+
+	if v, ok := replayer.Variable("random_integer"); !ok || v != "123" {
+		t.Fatalf("expected the variable `random_integer` to be %q but got %q", "123", v)
+	}
+
+	statusCode, body, err := send(t, replayer, http.MethodPut, url, `{"location": "westeurope"}`)
+	if err != nil {
+		t.Fatalf("replaying first request: %+v", err)
+	}
+	if statusCode != http.StatusCreated {
+		t.Fatalf("expected a 201 but got %d", statusCode)
+	}
+	if !strings.Contains(body, `"access_token": "SCRUBBED"`) {
+		t.Fatalf("expected the scrubbed response body but got %q", body)
+	}
+
+	statusCode, body, err = send(t, replayer, http.MethodGet, url, "")
+	if err != nil {
+		t.Fatalf("replaying second request: %+v", err)
+	}
+	if statusCode != http.StatusOK || !strings.Contains(body, "Succeeded") {
+		t.Fatalf("expected a 200 containing `Succeeded` but got %d: %q", statusCode, body)
+	}
+
+	if _, _, err := send(t, replayer, http.MethodGet, url, ""); err == nil {
+		t.Fatalf("expected an error since there are no further recorded responses but didn't get one")
+	}
+}
+
+func TestReplayInOrderForTheSameURL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	contents := `{
+  "variables": {},
+  "interactions": [
+    {"request": {"method": "GET", "url": "https://example.com/poll"}, "response": {"statusCode": 202, "headers": {"Retry-After": "30"}}},
+    {"request": {"method": "GET", "url": "https://example.com/other"}, "response": {"statusCode": 404}},
+    {"request": {"method": "GET", "url": "https://example.com/poll"}, "response": {"statusCode": 200}}
+  ]
+}`
+	if err := ioutil.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing cassette: %+v", err)
+	}
+
+	replayer, err := NewRecorder(ModeReplay, path)
+	if err != nil {
+		t.Fatalf("building replayer: %+v", err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://example.com/poll", nil)
+	resp, err := autorest.DecorateSender(&http.Client{}, replayer.SendDecorator()).Do(req)
+	if err != nil {
+		t.Fatalf("replaying first poll: %+v", err)
+	}
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected a 202 but got %d", resp.StatusCode)
+	}
+	if v := resp.Header.Get("Retry-After"); v != "0" {
+		t.Fatalf("expected the Retry-After header to be %q but got %q", "0", v)
+	}
+
+	statusCode, _, err := send(t, replayer, http.MethodGet, "https://example.com/poll", "")
+	if err != nil {
+		t.Fatalf("replaying second poll: %+v", err)
+	}
+	if statusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", statusCode)
+	}
+}
+
+func TestReplayWithoutCassette(t *testing.T) {
+	if _, err := NewRecorder(ModeReplay, filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Fatalf("expected an error when the cassette doesn't exist but didn't get one")
+	}
+}
+
+func TestCassettePath(t *testing.T) {
+	expected := filepath.Join("testdata", "recordings", "TestAccResourceGroup_basic_sub_test.json")
+	if actual := CassettePath("TestAccResourceGroup_basic/sub test"); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,38 @@
+package resourceproviders
+
+import "strings"
+
+// RegistrationMode defines which Resource Providers are automatically registered by the Provider
+type RegistrationMode string
+
+const (
+	// RegistrationModeAll registers all of the Resource Providers supported by the Provider
+	// (defined in `Required()`) when the Provider is configured
+	RegistrationModeAll RegistrationMode = "all"
+
+	// RegistrationModeNone doesn't automatically register any Resource Providers
+	RegistrationModeNone RegistrationMode = "none"
+
+	// RegistrationModeUsed registers the Resource Providers used by the Resources and Data Sources
+	// within the configuration, the first time that each of these is used
+	RegistrationModeUsed RegistrationMode = "used"
+)
+
+// PossibleRegistrationModes returns a list of the possible Resource Provider Registration Modes
+func PossibleRegistrationModes() []string {
+	return []string{
+		string(RegistrationModeAll),
+		string(RegistrationModeNone),
+		string(RegistrationModeUsed),
+	}
+}
+
+func isSkipped(resourceProvider string, skip []string) bool {
+	for _, v := range skip {
+		if strings.EqualFold(v, resourceProvider) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,82 @@
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+)
+
+// Registrar registers Resource Providers on-demand, as the Resources and Data Sources using them are used
+type Registrar struct {
+	client *resources.ProvidersClient
+	skip   []string
+
+	lock       *sync.Mutex
+	registered map[string]struct{}
+}
+
+// NewRegistrar returns a Registrar which registers Resource Providers using the specified client, other
+// than those in the list of Resource Providers to skip
+func NewRegistrar(client *resources.ProvidersClient, skip []string) *Registrar {
+	return &Registrar{
+		client:     client,
+		skip:       skip,
+		lock:       &sync.Mutex{},
+		registered: make(map[string]struct{}),
+	}
+}
+
+// EnsureRegistered ensures that the specified Resource Providers are registered - where a Resource Provider
+// has already been ensured to be registered by this Registrar, no further requests are made
+func (r *Registrar) EnsureRegistered(ctx context.Context, resourceProviders []string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	required := make([]string, 0)
+	for _, v := range resourceProviders {
+		if _, ok := r.registered[strings.ToLower(v)]; ok || isSkipped(v, r.skip) {
+			continue
+		}
+
+		required = append(required, v)
+	}
+
+	if len(required) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Ensuring the Resource Providers %q are registered", strings.Join(required, ", "))
+	providerList, err := r.client.List(ctx, nil, "")
+	if err != nil {
+		return fmt.Errorf("listing Resource Providers: %+v", err)
+	}
+
+	// the namespaces are compared case-sensitively, so these need to use the casing returned from the API
+	availableResourceProviders := providerList.Values()
+	requiredResourceProviders := make(map[string]struct{})
+	for _, provider := range availableResourceProviders {
+		if provider.Namespace == nil {
+			continue
+		}
+
+		for _, v := range required {
+			if strings.EqualFold(v, *provider.Namespace) {
+				requiredResourceProviders[*provider.Namespace] = struct{}{}
+			}
+		}
+	}
+
+	if err := EnsureRegistered(ctx, *r.client, availableResourceProviders, requiredResourceProviders); err != nil {
+		return err
+	}
+
+	for _, v := range required {
+		r.registered[strings.ToLower(v)] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package resourceproviders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+)
+
+func TestRegistrarEnsureRegistered(t *testing.T) {
+	lock := &sync.Mutex{}
+	listRequests := 0
+	registered := make([]string, 0)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/register") {
+			segments := strings.Split(r.URL.Path, "/")
+			registered = append(registered, segments[len(segments)-2])
+			w.Write([]byte(`{}`))
+			return
+		}
+
+		listRequests++
+		w.Write([]byte(`{"value": [
+  {"namespace": "Microsoft.Compute", "registrationState": "NotRegistered"},
+  {"namespace": "Microsoft.Network", "registrationState": "Registered"},
+  {"namespace": "Microsoft.Sql", "registrationState": "NotRegistered"}
+]}`))
+	}))
+	defer server.Close()
+
+	client := resources.NewProvidersClientWithBaseURI(server.URL, "12345678-1234-9876-4563-123456789012")
+	registrar := NewRegistrar(&client, []string{"microsoft.sql"})
+
+	if err := registrar.EnsureRegistered(context.TODO(), []string{"microsoft.compute", "Microsoft.Network", "Microsoft.Sql"}); err != nil {
+		t.Fatalf("ensuring registered: %+v", err)
+	}
+
+	// the Resource Providers are cached, so a second call shouldn't list the Resource Providers again
+	if err := registrar.EnsureRegistered(context.TODO(), []string{"Microsoft.Compute", "Microsoft.Sql"}); err != nil {
+		t.Fatalf("ensuring registered: %+v", err)
+	}
+
+	sort.Strings(registered)
+	if len(registered) != 1 || registered[0] != "Microsoft.Compute" {
+		t.Fatalf("expected only `Microsoft.Compute` to be registered but got %q", registered)
+	}
+	if listRequests != 1 {
+		t.Fatalf("expected the Resource Providers to be listed once but got %d", listRequests)
+	}
+}
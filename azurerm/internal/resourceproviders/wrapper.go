@@ -0,0 +1,100 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// EnsureRegisteredFunc ensures the specified Resource Providers are registered, should the Provider
+// be configured to register the Resource Providers used by each Resource and Data Source on-demand
+type EnsureRegisteredFunc func(meta interface{}, resourceProviders []string) error
+
+// ApplyRegistration updates the Resource (or Data Source) so that the Resource Providers it uses are registered
+// prior to it being created (or for a Data Source, read) - and so that any error caused by a Resource Provider
+// not being registered explains which Resource Provider needs to be registered
+func ApplyRegistration(resource *schema.Resource, resourceProviders []string, ensureRegistered EnsureRegisteredFunc) {
+	if resource == nil {
+		return
+	}
+
+	// Data Sources don't have a Create function, so the Resource Providers are registered prior to being read
+	isDataSource := resource.Create == nil
+
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			if err := ensureRegistered(meta, resourceProviders); err != nil {
+				return fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
+			}
+
+			return missingRegistrationError(create(d, meta))
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if isDataSource {
+				if err := ensureRegistered(meta, resourceProviders); err != nil {
+					return fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
+				}
+			}
+
+			return missingRegistrationError(read(d, meta))
+		}
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return missingRegistrationError(update(d, meta))
+		}
+	}
+
+	if del := resource.Delete; del != nil {
+		resource.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return missingRegistrationError(del(d, meta))
+		}
+	}
+}
+
+var missingRegistrationNamespaceRegex = regexp.MustCompile(`(?i)not registered to use namespace '([^']+)'`)
+
+// missingRegistrationError returns an error explaining which Resource Provider needs to be registered, when
+// the specified error was returned from an API because a Resource Provider isn't registered
+func missingRegistrationError(err error) error {
+	if err == nil || !strings.Contains(err.Error(), "MissingSubscriptionRegistration") {
+		return err
+	}
+
+	resourceProvider := "used by this resource"
+	if v := missingRegistrationNamespaceRegex.FindStringSubmatch(err.Error()); len(v) == 2 {
+		resourceProvider = fmt.Sprintf("%q", v[1])
+	}
+
+	return fmt.Errorf(missingRegistrationErrorFmt, resourceProvider, err)
+}
+
+const missingRegistrationErrorFmt = `the Resource Provider %s is not registered in this Subscription.
+
+This Resource Provider can be registered automatically by Terraform, either by adding it
+to the "resource_providers_to_register" list in the Provider block, or by setting
+"resource_provider_registration_mode" to "used" in the Provider block (which registers
+the Resource Providers used by the configuration). Alternatively this Resource Provider
+can be registered using the "azurerm_resource_provider_registration" resource.
+
+More information on Resource Provider Registration can be found here:
+https://www.terraform.io/docs/providers/azurerm/index.html#resource_provider_registration_mode
+
+Original Error: %+v`
+
+const resourceProviderRegistrationErrorFmt = `ensuring the Resource Providers used by this resource are registered.
+
+Terraform is configured to automatically register the Resource Providers used by the
+configuration ("resource_provider_registration_mode" is set to "used").
+
+If you don't have permission to register a Resource Provider you may wish to add it to
+the "resource_providers_to_skip" list in the Provider block, or to disable this functionality
+by setting "resource_provider_registration_mode" to "none".
+
+Original Error: %+v`
@@ -0,0 +1,118 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestApplyRegistration(t *testing.T) {
+	var registered []string
+	ensureRegistered := func(meta interface{}, resourceProviders []string) error {
+		registered = append(registered, resourceProviders...)
+		return nil
+	}
+	noop := func(d *schema.ResourceData, meta interface{}) error {
+		return nil
+	}
+
+	resource := &schema.Resource{
+		Create: noop,
+		Read:   noop,
+		Update: noop,
+		Delete: noop,
+	}
+	ApplyRegistration(resource, []string{"Microsoft.Compute"}, ensureRegistered)
+
+	for _, f := range []func(*schema.ResourceData, interface{}) error{resource.Read, resource.Update, resource.Delete} {
+		if err := f(nil, nil); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+	if len(registered) != 0 {
+		t.Fatalf("expected no Resource Providers to be registered prior to the Create but got %q", registered)
+	}
+
+	if err := resource.Create(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(registered) != 1 || registered[0] != "Microsoft.Compute" {
+		t.Fatalf("expected `Microsoft.Compute` to be registered but got %q", registered)
+	}
+
+	registered = nil
+	dataSource := &schema.Resource{
+		Read: noop,
+	}
+	ApplyRegistration(dataSource, []string{"Microsoft.Network"}, ensureRegistered)
+	if err := dataSource.Read(nil, nil); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if len(registered) != 1 || registered[0] != "Microsoft.Network" {
+		t.Fatalf("expected `Microsoft.Network` to be registered but got %q", registered)
+	}
+}
+
+func TestApplyRegistrationFailure(t *testing.T) {
+	ensureRegistered := func(meta interface{}, resourceProviders []string) error {
+		return fmt.Errorf("forbidden")
+	}
+	created := false
+	resource := &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			created = true
+			return nil
+		},
+	}
+	ApplyRegistration(resource, []string{"Microsoft.Compute"}, ensureRegistered)
+
+	err := resource.Create(nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "forbidden") {
+		t.Fatalf("expected an error containing `forbidden` but got: %+v", err)
+	}
+	if created {
+		t.Fatalf("expected the resource not to be created when the Resource Providers couldn't be registered")
+	}
+}
+
+func TestMissingRegistrationError(t *testing.T) {
+	testCases := []struct {
+		input    error
+		expected string
+	}{
+		{
+			input:    nil,
+			expected: "",
+		},
+		{
+			input:    fmt.Errorf("some other error"),
+			expected: "some other error",
+		},
+		{
+			input:    fmt.Errorf(`compute.VirtualMachinesClient#CreateOrUpdate: Failure sending request: StatusCode=409 -- Original Error: Code="MissingSubscriptionRegistration" Message="The subscription is not registered to use namespace 'Microsoft.Compute'. See https://aka.ms/rps-not-found for how to register subscriptions."`),
+			expected: `the Resource Provider "Microsoft.Compute" is not registered in this Subscription`,
+		},
+		{
+			input:    fmt.Errorf(`Code="MissingSubscriptionRegistration"`),
+			expected: "the Resource Provider used by this resource is not registered in this Subscription",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %+v..", testCase.input)
+
+		actual := missingRegistrationError(testCase.input)
+		if testCase.expected == "" {
+			if actual != nil {
+				t.Fatalf("expected no error but got: %+v", actual)
+			}
+			continue
+		}
+
+		if actual == nil || !strings.Contains(actual.Error(), testCase.expected) {
+			t.Fatalf("expected an error containing %q but got: %+v", testCase.expected, actual)
+		}
+	}
+}
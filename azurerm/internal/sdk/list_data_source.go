@@ -0,0 +1,313 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// A List Data Source is an object which looks up a list of existing resources, which are
+// then filtered using the information specified in the Terraform Configuration
+//
+// In addition to the Arguments defined by the implementation, all List Data Sources support
+// filtering the results by `name_regex` and `required_tags` - and return the results in a
+// deterministic order (sorted by Name and then ID)
+//
+// List Data Sources are exposed as a regular Data Source by calling NewListDataSource
+type ListDataSource interface {
+	// Arguments is a list of user-configurable filters for this Data Source
+	// NOTE: `name_regex` and `required_tags` are reserved and added automatically
+	Arguments() map[string]*schema.Schema
+
+	// ResultAttributes is the Schema for each of the results returned from this Data Source
+	ResultAttributes() map[string]*schema.Schema
+
+	// ResultsKey is the name of the attribute the results are set into (e.g. `public_ips`)
+	ResultsKey() string
+
+	// FilterModelObject is an instance of the object the Arguments are decoded into
+	FilterModelObject() interface{}
+
+	// ResultModelObject is an instance of the object each of the results are encoded from
+	ResultModelObject() interface{}
+
+	// ResourceType is the exposed name of this Data Source (e.g. `azurerm_example`)
+	ResourceType() string
+
+	// List is a ListFunc which returns an iterator over the (unfiltered) results
+	List() ListFunc
+}
+
+// ListRunFunc is the function which returns a ListIterator over the results
+// ctx provides a Context instance with the user-provided timeout
+// metadata can be used to Decode the FilterModelObject, for example to filter server-side
+type ListRunFunc func(ctx context.Context, metadata ResourceMetaData) (ListIterator, error)
+
+type ListFunc struct {
+	// Func is the function which should be called to retrieve the results
+	Func ListRunFunc
+
+	// Timeout is the default timeout, which can be overridden by users
+	// for this method - in-turn used for the Azure API
+	Timeout time.Duration
+}
+
+// ListIterator is an iterator over the results returned from the Azure API
+type ListIterator interface {
+	// NotDone returns whether there are further items to iterate over
+	NotDone() bool
+
+	// NextWithContext advances the iterator to the next item, retrieving the next page if necessary
+	NextWithContext(ctx context.Context) error
+
+	// Item returns the current item - or nil if this item should be skipped
+	Item() (*ListItem, error)
+}
+
+// ListItem is a single result returned from a ListIterator
+type ListItem struct {
+	// ID is the Resource ID for this item, which is used to order results with the same Name
+	ID string
+
+	// Name is the Name of this item, which is used for filtering by `name_regex`
+	Name string
+
+	// Tags are the Tags for this item, which are used for filtering by `required_tags`
+	Tags map[string]string
+
+	// Model is an instance of the ResultModelObject which is encoded into the results
+	Model interface{}
+}
+
+// AutoRestIterator is the subset of the Iterators returned from the `ListComplete`
+// functions in the Azure SDK which is required to iterate over the results
+type AutoRestIterator interface {
+	NotDone() bool
+	NextWithContext(ctx context.Context) error
+}
+
+// NewListIterator returns a ListIterator for the Iterator returned from a `ListComplete`
+// function in the Azure SDK - where itemFunc maps the current Value into a ListItem
+//
+// Example Usage:
+//
+//	iterator, err := client.ListAllComplete(ctx)
+//	if err != nil { .. }
+//	return sdk.NewListIterator(&iterator, func() (*sdk.ListItem, error) {
+//		value := iterator.Value()
+//		..
+//	}), nil
+func NewListIterator(iterator AutoRestIterator, itemFunc func() (*ListItem, error)) ListIterator {
+	return autoRestListIterator{
+		iterator: iterator,
+		itemFunc: itemFunc,
+	}
+}
+
+type autoRestListIterator struct {
+	iterator AutoRestIterator
+	itemFunc func() (*ListItem, error)
+}
+
+func (i autoRestListIterator) NotDone() bool {
+	return i.iterator.NotDone()
+}
+
+func (i autoRestListIterator) NextWithContext(ctx context.Context) error {
+	return i.iterator.NextWithContext(ctx)
+}
+
+func (i autoRestListIterator) Item() (*ListItem, error) {
+	return i.itemFunc()
+}
+
+var _ DataSource = listDataSource{}
+
+// NewListDataSource returns a DataSource for this List Data Source implementation
+// which can then be registered using a TypedServiceRegistration
+func NewListDataSource(dataSource ListDataSource) DataSource {
+	return listDataSource{
+		dataSource: dataSource,
+	}
+}
+
+type listDataSource struct {
+	dataSource ListDataSource
+}
+
+func (l listDataSource) Arguments() map[string]*schema.Schema {
+	out := make(map[string]*schema.Schema)
+	for k, v := range l.dataSource.Arguments() {
+		out[k] = v
+	}
+
+	out["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+
+	out["required_tags"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+
+	return out
+}
+
+func (l listDataSource) Attributes() map[string]*schema.Schema {
+	results := make(map[string]*schema.Schema)
+	for k, v := range l.dataSource.ResultAttributes() {
+		// every result is read-only - since the schema can be shared this is updated on a copy
+		result := *v
+		result.Computed = true
+		result.Required = false
+		result.Optional = false
+		result.ForceNew = false
+		result.Default = nil
+		result.ValidateFunc = nil
+		result.DiffSuppressFunc = nil
+		results[k] = &result
+	}
+
+	return map[string]*schema.Schema{
+		l.dataSource.ResultsKey(): {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: results,
+			},
+		},
+	}
+}
+
+func (l listDataSource) ModelObject() interface{} {
+	return l.dataSource.FilterModelObject()
+}
+
+func (l listDataSource) ResourceType() string {
+	return l.dataSource.ResourceType()
+}
+
+func (l listDataSource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			var nameRegex *regexp.Regexp
+			if v, ok := metadata.ResourceData.GetOk("name_regex"); ok {
+				r, err := regexp.Compile(v.(string))
+				if err != nil {
+					return fmt.Errorf("compiling `name_regex`: %+v", err)
+				}
+				nameRegex = r
+			}
+
+			requiredTags := make(map[string]string)
+			for k, v := range metadata.ResourceData.Get("required_tags").(map[string]interface{}) {
+				requiredTags[k] = v.(string)
+			}
+
+			iterator, err := l.dataSource.List().Func(ctx, metadata)
+			if err != nil {
+				return err
+			}
+
+			items, err := filterListItems(ctx, iterator, nameRegex, requiredTags)
+			if err != nil {
+				return fmt.Errorf("listing %s: %+v", l.dataSource.ResourceType(), err)
+			}
+
+			results, err := encodeListItems(items, l.dataSource.ResultModelObject(), metadata.serializationDebugLogger)
+			if err != nil {
+				return fmt.Errorf("encoding results for %s: %+v", l.dataSource.ResourceType(), err)
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s-%s", l.dataSource.ResourceType(), time.Now().UTC().String()))
+			if err := metadata.ResourceData.Set(l.dataSource.ResultsKey(), results); err != nil {
+				return fmt.Errorf("setting `%s`: %+v", l.dataSource.ResultsKey(), err)
+			}
+
+			return nil
+		},
+		Timeout: l.dataSource.List().Timeout,
+	}
+}
+
+// filterListItems iterates over all of the items in the iterator, returning the items
+// matching the Name Regex and Required Tags, sorted by Name (and then ID)
+func filterListItems(ctx context.Context, iterator ListIterator, nameRegex *regexp.Regexp, requiredTags map[string]string) ([]ListItem, error) {
+	items := make([]ListItem, 0)
+	for iterator.NotDone() {
+		item, err := iterator.Item()
+		if err != nil {
+			return nil, err
+		}
+
+		if item != nil && listItemMatches(*item, nameRegex, requiredTags) {
+			items = append(items, *item)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if !strings.EqualFold(items[i].Name, items[j].Name) {
+			return strings.ToLower(items[i].Name) < strings.ToLower(items[j].Name)
+		}
+
+		return strings.ToLower(items[i].ID) < strings.ToLower(items[j].ID)
+	})
+
+	return items, nil
+}
+
+func listItemMatches(item ListItem, nameRegex *regexp.Regexp, requiredTags map[string]string) bool {
+	if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+		return false
+	}
+
+	for k, v := range requiredTags {
+		actual, exists := item.Tags[k]
+		if !exists || actual != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func encodeListItems(items []ListItem, resultModel interface{}, debugLogger Logger) ([]interface{}, error) {
+	expectedType := reflect.Indirect(reflect.ValueOf(resultModel)).Type()
+
+	results := make([]interface{}, 0)
+	for _, item := range items {
+		if item.Model == nil {
+			return nil, fmt.Errorf("the Model for %q was nil", item.ID)
+		}
+
+		val := reflect.Indirect(reflect.ValueOf(item.Model))
+		if val.Type() != expectedType {
+			return nil, fmt.Errorf("the Model for %q was a %s but expected a %s", item.ID, val.Type(), expectedType)
+		}
+
+		serialized, err := recurse(val.Type(), val, item.Name, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing %q: %+v", item.ID, err)
+		}
+
+		results = append(results, serialized)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,330 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+type listTestFilter struct {
+	Location string `tfschema:"location"`
+}
+
+type listTestResult struct {
+	Id       string `tfschema:"id"`
+	Name     string `tfschema:"name"`
+	Location string `tfschema:"location"`
+}
+
+// listTestIterator is a paged iterator matching the `ListComplete` iterators in the Azure SDK
+type listTestIterator struct {
+	pages [][]listTestResult
+	page  int
+	index int
+}
+
+func (i *listTestIterator) NotDone() bool {
+	return i.page < len(i.pages) && i.index < len(i.pages[i.page])
+}
+
+func (i *listTestIterator) NextWithContext(_ context.Context) error {
+	i.index++
+	if i.index >= len(i.pages[i.page]) {
+		i.page++
+		i.index = 0
+	}
+	return nil
+}
+
+func (i *listTestIterator) Value() listTestResult {
+	return i.pages[i.page][i.index]
+}
+
+func newListTestIterator(pages ...[]listTestResult) ListIterator {
+	iterator := &listTestIterator{
+		pages: pages,
+	}
+	return NewListIterator(iterator, func() (*ListItem, error) {
+		value := iterator.Value()
+		return &ListItem{
+			ID:   value.Id,
+			Name: value.Name,
+			Tags: map[string]string{
+				"location": value.Location,
+			},
+			Model: value,
+		}, nil
+	})
+}
+
+var listTestPages = [][]listTestResult{
+	{
+		{Id: "/things/d", Name: "delta", Location: "westeurope"},
+		{Id: "/things/b", Name: "bravo", Location: "westus"},
+	},
+	{
+		{Id: "/things/a2", Name: "alpha", Location: "westeurope"},
+		{Id: "/things/a1", Name: "alpha", Location: "westeurope"},
+		{Id: "/things/c", Name: "Charlie", Location: "eastus"},
+	},
+}
+
+func TestFilterListItems(t *testing.T) {
+	testData := []struct {
+		name         string
+		nameRegex    *regexp.Regexp
+		requiredTags map[string]string
+		expected     []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"/things/a1", "/things/a2", "/things/b", "/things/c", "/things/d"},
+		},
+		{
+			name:      "name regex",
+			nameRegex: regexp.MustCompile("^(alpha|delta)$"),
+			expected:  []string{"/things/a1", "/things/a2", "/things/d"},
+		},
+		{
+			name: "required tags",
+			requiredTags: map[string]string{
+				"location": "westeurope",
+			},
+			expected: []string{"/things/a1", "/things/a2", "/things/d"},
+		},
+		{
+			name:      "name regex and required tags",
+			nameRegex: regexp.MustCompile("^d"),
+			requiredTags: map[string]string{
+				"location": "westeurope",
+			},
+			expected: []string{"/things/d"},
+		},
+		{
+			name: "required tags with no match",
+			requiredTags: map[string]string{
+				"owner": "someone",
+			},
+			expected: []string{},
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		items, err := filterListItems(context.TODO(), newListTestIterator(listTestPages...), v.nameRegex, v.requiredTags)
+		if err != nil {
+			t.Fatalf("filtering: %+v", err)
+		}
+
+		actual := make([]string, 0)
+		for _, item := range items {
+			actual = append(actual, item.ID)
+		}
+		if !reflect.DeepEqual(v.expected, actual) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestEncodeListItems(t *testing.T) {
+	items := []ListItem{
+		{
+			ID:    "/things/a",
+			Name:  "alpha",
+			Model: listTestResult{Id: "/things/a", Name: "alpha", Location: "westeurope"},
+		},
+	}
+	actual, err := encodeListItems(items, listTestResult{}, NullLogger{})
+	if err != nil {
+		t.Fatalf("encoding: %+v", err)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"id":       "/things/a",
+			"name":     "alpha",
+			"location": "westeurope",
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	items[0].Model = listTestFilter{Location: "westeurope"}
+	if _, err := encodeListItems(items, listTestResult{}, NullLogger{}); err == nil {
+		t.Fatalf("expected an error for a mismatched Model but didn't get one")
+	}
+}
+
+type listTestDataSource struct{}
+
+func (listTestDataSource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"location": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (listTestDataSource) ResultAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type: schema.TypeString,
+		},
+		"name": {
+			Type: schema.TypeString,
+		},
+		"location": {
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func (listTestDataSource) ResultsKey() string {
+	return "things"
+}
+
+func (listTestDataSource) FilterModelObject() interface{} {
+	return listTestFilter{}
+}
+
+func (listTestDataSource) ResultModelObject() interface{} {
+	return listTestResult{}
+}
+
+func (listTestDataSource) ResourceType() string {
+	return "validator_things"
+}
+
+func (listTestDataSource) List() ListFunc {
+	return ListFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) (ListIterator, error) {
+			var filter listTestFilter
+			if err := metadata.Decode(&filter); err != nil {
+				return nil, fmt.Errorf("decoding: %+v", err)
+			}
+
+			// filtering server-side is specific to each API, so this is filtered here
+			pages := make([][]listTestResult, 0)
+			for _, page := range listTestPages {
+				items := make([]listTestResult, 0)
+				for _, item := range page {
+					if filter.Location == "" || filter.Location == item.Location {
+						items = append(items, item)
+					}
+				}
+				if len(items) > 0 {
+					pages = append(pages, items)
+				}
+			}
+
+			return newListTestIterator(pages...), nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestAccPluginSDKListDataSource(t *testing.T) {
+	os.Setenv("TF_ACC", "1")
+
+	wrapper := NewDataSourceWrapper(NewListDataSource(listTestDataSource{}))
+	dataSource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	dataSourceName := "data.validator_things.test"
+	// lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: map[string]terraform.ResourceProviderFactory{
+			"validator": func() (terraform.ResourceProvider, error) {
+				return &schema.Provider{
+					DataSourcesMap: map[string]*schema.Resource{
+						"validator_things": dataSource,
+					},
+					ResourcesMap: map[string]*schema.Resource{},
+					ConfigureFunc: func(_ *schema.ResourceData) (interface{}, error) {
+						return &clients.Client{
+							StopContext: context.Background(),
+						}, nil
+					},
+				}, nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `data "validator_things" "test" {
+  location   = "westeurope"
+  name_regex = "^a"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "things.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "things.0.id", "/things/a1"),
+					resource.TestCheckResourceAttr(dataSourceName, "things.1.id", "/things/a2"),
+					resource.TestCheckResourceAttr(dataSourceName, "things.1.location", "westeurope"),
+				),
+			},
+		},
+	})
+}
+
+// listTestSharedSchemaDataSource returns the Result Attributes from a (shared) Resource schema
+type listTestSharedSchemaDataSource struct {
+	listTestDataSource
+	shared map[string]*schema.Schema
+}
+
+func (l listTestSharedSchemaDataSource) ResultAttributes() map[string]*schema.Schema {
+	return l.shared
+}
+
+func TestListDataSourceAttributesCopiesSchema(t *testing.T) {
+	shared := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"location": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "westeurope",
+		},
+	}
+
+	dataSource := NewListDataSource(listTestSharedSchemaDataSource{
+		shared: shared,
+	})
+	attributes := dataSource.Attributes()
+	results := attributes["things"].Elem.(*schema.Resource).Schema
+
+	for k, v := range results {
+		if !v.Computed || v.Required || v.Optional || v.ForceNew || v.Default != nil || v.ValidateFunc != nil {
+			t.Fatalf("expected %q to be Computed-only but got %+v", k, v)
+		}
+	}
+
+	// the shared schema shouldn't have been modified
+	if name := shared["name"]; name.Computed || !name.Required || name.ValidateFunc == nil {
+		t.Fatalf("expected the shared schema for `name` to be unchanged but got %+v", name)
+	}
+	if location := shared["location"]; location.Computed || !location.Optional || location.Default == nil {
+		t.Fatalf("expected the shared schema for `location` to be unchanged but got %+v", location)
+	}
+
+	if err := (&schema.Resource{Schema: attributes}).InternalValidate(nil, false); err != nil {
+		t.Fatalf("validating the schema: %+v", err)
+	}
+}
@@ -2,6 +2,13 @@ package sdk
 
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +22,45 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a Logger which includes the specified fields
+	// (in addition to any existing fields) in each message
+	WithFields(fields LogFields) Logger
+}
+
+// LogFields is a set of key/value pairs which are output alongside each message
+// for example the Resource Type, Resource ID and the CRUD operation
+type LogFields map[string]interface{}
+
+const (
+	// LogFieldCorrelationRequestID is the `x-ms-correlation-request-id` sent to Azure
+	LogFieldCorrelationRequestID = "correlation_request_id"
+
+	// LogFieldOperation is the operation being performed (e.g. `create` or `read`)
+	LogFieldOperation = "operation"
+
+	// LogFieldResourceID is the ID of the Resource being operated on
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldResourceType is the type of the Resource being operated on (e.g. `azurerm_example`)
+	LogFieldResourceType = "resource_type"
+)
+
+// merge returns a new LogFields containing the existing and specified fields
+func (f LogFields) merge(fields LogFields) LogFields {
+	out := make(LogFields)
+	for k, v := range f {
+		out[k] = v
+	}
+	for k, v := range fields {
+		out[k] = v
+	}
+	return out
 }
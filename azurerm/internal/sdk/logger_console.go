@@ -1,18 +1,39 @@
 package sdk
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"os"
+	"sort"
+	"strings"
 )
 
+// LogFormatEnvironmentVariable is the Environment Variable used to switch the
+// output format of the ConsoleLogger - setting this to `json` outputs each message
+// (and the associated fields) as a JSON object
+const LogFormatEnvironmentVariable = "ARM_LOG_FORMAT"
+
 // ConsoleLogger provides a Logger implementation which writes the log messages
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
 type ConsoleLogger struct {
+	fields LogFields
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (l ConsoleLogger) Debug(message string) {
+	l.write("DEBUG", message)
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
 }
 
 // Info prints out a message prefixed with `[INFO]` verbatim
-func (ConsoleLogger) Info(message string) {
-	log.Print(fmt.Sprintf("[INFO] %s", message))
+func (l ConsoleLogger) Info(message string) {
+	l.write("INFO", message)
 }
 
 // Infof prints out a message prefixed with `[INFO]` formatted
@@ -23,7 +44,7 @@ func (l ConsoleLogger) Infof(format string, args ...interface{}) {
 
 // Warn prints out a message prefixed with `[WARN]` formatted verbatim
 func (l ConsoleLogger) Warn(message string) {
-	log.Print(fmt.Sprintf("[WARN] %s", message))
+	l.write("WARN", message)
 }
 
 // Warnf prints out a message prefixed with `[WARN]` formatted
@@ -31,3 +52,63 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (l ConsoleLogger) Error(message string) {
+	l.write("ERROR", message)
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a ConsoleLogger which includes the specified fields in each message
+func (l ConsoleLogger) WithFields(fields LogFields) Logger {
+	return ConsoleLogger{
+		fields: l.fields.merge(fields),
+	}
+}
+
+func (l ConsoleLogger) write(level string, message string) {
+	log.Print(formatLogMessage(level, message, l.fields, strings.EqualFold(os.Getenv(LogFormatEnvironmentVariable), "json")))
+}
+
+// formatLogMessage formats the message and fields, retaining the `[LEVEL]` prefix
+// in both formats so that Terraform's log-level filtering continues to work
+func formatLogMessage(level string, message string, fields LogFields, outputJSON bool) string {
+	keys := make([]string, 0)
+	for k, v := range fields {
+		if v == nil || v == "" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if outputJSON {
+		out := map[string]interface{}{
+			"@level":   strings.ToLower(level),
+			"@message": message,
+		}
+		for _, k := range keys {
+			out[k] = fields[k]
+		}
+
+		// encoding/json sorts the keys, so the output is deterministic
+		if encoded, err := json.Marshal(out); err == nil {
+			return fmt.Sprintf("[%s] %s", level, string(encoded))
+		}
+	}
+
+	if len(keys) == 0 {
+		return fmt.Sprintf("[%s] %s", level, message)
+	}
+
+	pairs := make([]string, 0)
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, fmt.Sprintf("%v", fields[k])))
+	}
+	return fmt.Sprintf("[%s] %s (%s)", level, message, strings.Join(pairs, " "))
+}
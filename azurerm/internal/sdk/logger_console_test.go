@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"testing"
+)
+
+func TestFormatLogMessage(t *testing.T) {
+	testData := []struct {
+		name       string
+		level      string
+		message    string
+		fields     LogFields
+		outputJSON bool
+		expected   string
+	}{
+		{
+			name:     "no fields",
+			level:    "INFO",
+			message:  "hello world",
+			expected: "[INFO] hello world",
+		},
+		{
+			name:    "fields",
+			level:   "DEBUG",
+			message: "hello world",
+			fields: LogFields{
+				LogFieldResourceType: "azurerm_example",
+				LogFieldOperation:    "create",
+				LogFieldResourceID:   "",
+			},
+			expected: `[DEBUG] hello world (operation="create" resource_type="azurerm_example")`,
+		},
+		{
+			name:       "json without fields",
+			level:      "WARN",
+			message:    "hello world",
+			outputJSON: true,
+			expected:   `[WARN] {"@level":"warn","@message":"hello world"}`,
+		},
+		{
+			name:    "json with fields",
+			level:   "ERROR",
+			message: "hello \"world\"",
+			fields: LogFields{
+				LogFieldResourceType:         "azurerm_example",
+				LogFieldCorrelationRequestID: "00000000-0000-0000-0000-000000000000",
+				LogFieldResourceID:           nil,
+			},
+			outputJSON: true,
+			expected:   `[ERROR] {"@level":"error","@message":"hello \"world\"","correlation_request_id":"00000000-0000-0000-0000-000000000000","resource_type":"azurerm_example"}`,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := formatLogMessage(v.level, v.message, v.fields, v.outputJSON)
+		if actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestConsoleLoggerWithFields(t *testing.T) {
+	first := ConsoleLogger{}.WithFields(LogFields{
+		LogFieldResourceType: "azurerm_example",
+	})
+	second := first.WithFields(LogFields{
+		LogFieldOperation: "read",
+	})
+
+	if fields := first.(ConsoleLogger).fields; len(fields) != 1 {
+		t.Fatalf("expected the first logger to have 1 field but got %d", len(fields))
+	}
+
+	fields := second.(ConsoleLogger).fields
+	if len(fields) != 2 {
+		t.Fatalf("expected the second logger to have 2 fields but got %d", len(fields))
+	}
+	if fields[LogFieldResourceType] != "azurerm_example" || fields[LogFieldOperation] != "read" {
+		t.Fatalf("unexpected fields: %+v", fields)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -903,6 +904,85 @@ func TestAccPluginSDKReturnsComputedFields(t *testing.T) {
 	})
 }
 
+func TestAccPluginSDKAndDecoderCustomizeDiff(t *testing.T) {
+	os.Setenv("TF_ACC", "1")
+
+	type MyType struct {
+		Sku      string `tfschema:"sku"`
+		Capacity int    `tfschema:"capacity"`
+	}
+
+	// lintignore:AT001
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: map[string]terraform.ResourceProviderFactory{
+			"validator": func() (terraform.ResourceProvider, error) {
+				return &schema.Provider{
+					DataSourcesMap: map[string]*schema.Resource{},
+					ResourcesMap: map[string]*schema.Resource{
+						"validator_diff": {
+							Schema: map[string]*schema.Schema{
+								"sku": {
+									Type:     schema.TypeString,
+									Required: true,
+								},
+								"capacity": {
+									Type:     schema.TypeInt,
+									Optional: true,
+								},
+							},
+							Create: func(d *schema.ResourceData, _ interface{}) error {
+								d.SetId("some-id")
+								return nil
+							},
+							Read: func(_ *schema.ResourceData, _ interface{}) error {
+								return nil
+							},
+							Update: func(_ *schema.ResourceData, _ interface{}) error {
+								return nil
+							},
+							Delete: func(_ *schema.ResourceData, _ interface{}) error {
+								return nil
+							},
+							CustomizeDiff: func(d *schema.ResourceDiff, _ interface{}) error {
+								wrapper := ResourceMetaData{
+									ResourceDiff:             d,
+									Logger:                   ConsoleLogger{},
+									serializationDebugLogger: ConsoleLogger{},
+								}
+
+								var actual MyType
+								if err := wrapper.Decode(&actual); err != nil {
+									return fmt.Errorf("decoding: %+v", err)
+								}
+
+								if actual.Sku == "Premium" && actual.Capacity == 0 {
+									return fmt.Errorf("`capacity` must be specified when `sku` is `Premium`")
+								}
+
+								return nil
+							},
+						},
+					},
+				}, nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "validator_diff" "test" {
+  sku = "Premium"
+}`,
+				ExpectError: regexp.MustCompile("`capacity` must be specified when `sku` is `Premium`"),
+			},
+			{
+				Config: `resource "validator_diff" "test" {
+  sku      = "Premium"
+  capacity = 2
+}`,
+			},
+		},
+	})
+}
+
 func computedFieldsResource() *schema.Resource {
 	var readFunc = func(d *schema.ResourceData, _ interface{}) error {
 		d.Set("hello", "world")
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -62,9 +63,20 @@ type Resource interface {
 	IDValidationFunc() schema.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can customize the Diff during
+// `terraform plan` - for example to validate that fields are compatible
+// with one another, or to mark a field as ForceNew depending on its value.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc which is run during `terraform plan`
+	// NOTE: the ResourceMetaData passed into this function contains a reference
+	// to the ResourceDiff rather than the ResourceData - as such the Decode
+	// function returns the planned values for this Resource
+	CustomizeDiff() ResourceFunc
+}
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -73,6 +85,23 @@ type ResourceWithCustomImporter interface {
 	CustomImporter() ResourceRunFunc
 }
 
+// ResourceWithIDNormalization is an optional interface
+//
+// Resources implementing this interface have the Resource ID specified during
+// `terraform import` rewritten into the canonical casing (for example rewriting
+// `resourcegroups` to `resourceGroups`) before it's validated - since Azure
+// frequently returns Resource IDs using a different casing.
+type ResourceWithIDNormalization interface {
+	Resource
+
+	// IDNormalizationFunc returns the function used to rewrite the Resource ID into the canonical
+	// casing - which is generated alongside the Resource ID Parser, e.g. `parse.NormalizeServerID`
+	IDNormalizationFunc() IDNormalizationFunc
+}
+
+// IDNormalizationFunc returns the specified Resource ID using the canonical casing
+type IDNormalizationFunc func(input string) (string, error)
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
@@ -99,6 +128,20 @@ type ResourceWithDeprecation interface {
 	DeprecationMessage() string
 }
 
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface will have their existing State
+// upgraded to the latest Schema Version by the shim layer, allowing the
+// Schema (or the format of the Resource ID) to change without requiring
+// users to recreate these resources.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the current Schema Version alongside the
+	// StateUpgrade's required to migrate from each previous Schema Version
+	StateUpgraders() StateUpgradeData
+}
+
 // ResourceRunFunc is the function which can be run
 // ctx provides a Context instance with the user-provided timeout
 // metadata is a reference to an object containing the Client, ResourceData and a Logger
@@ -111,6 +154,8 @@ type ResourceFunc struct {
 
 	// Timeout is the default timeout, which can be overridden by users
 	// for this method - in-turn used for the Azure API
+	// NOTE: users can also override this default for the Resource Type (or Service)
+	// using the `timeouts` block within the `features` block of the Provider
 	Timeout time.Duration
 }
 
@@ -126,12 +171,21 @@ type ResourceMetaData struct {
 	// for example, to determine if a field has changes
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// NOTE: this is only populated during a CustomizeDiff, where ResourceData is nil - as such Encode,
+	// MarkAsGone and SetID can't be used during a CustomizeDiff
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone() error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("a resource can't be marked as gone during a CustomizeDiff")
+	}
+
 	rmd.ResourceData.SetId("")
 	return nil
 }
@@ -18,8 +18,18 @@ import (
 // }
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
+//
+// During a CustomizeDiff this decodes the planned values from the ResourceDiff
 func (rmd ResourceMetaData) Decode(input interface{}) error {
-	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
+	if rmd.ResourceData != nil {
+		return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
+	}
+
+	if rmd.ResourceDiff != nil {
+		return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+	}
+
+	return fmt.Errorf("neither ResourceData or ResourceDiff were set")
 }
 
 // stateRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
@@ -9,6 +9,10 @@ import (
 // NOTE: this requires that the object passed in is a pointer and
 // all fields contain `tfschema` struct tags
 func (rmd ResourceMetaData) Encode(input interface{}) error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("the State can't be set during a CustomizeDiff")
+	}
+
 	if reflect.TypeOf(input).Kind() != reflect.Ptr {
 		return fmt.Errorf("need a pointer")
 	}
@@ -3,6 +3,12 @@ package sdk
 import "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 
 // SetID uses the specified ID Formatter to set the Resource ID
+// NOTE: the ID can't be set during a CustomizeDiff, where this is a no-op
 func (rmd ResourceMetaData) SetID(formatter resourceid.Formatter) {
+	if rmd.ResourceData == nil {
+		rmd.Logger.Warnf("unable to set the ID %q since the ResourceData isn't available", formatter.ID())
+		return
+	}
+
 	rmd.ResourceData.SetId(formatter.ID())
 }
@@ -20,6 +20,10 @@ type TypedServiceRegistration interface {
 
 	// WebsiteCategories returns a list of categories which can be used for the sidebar
 	WebsiteCategories() []string
+
+	// ResourceProviders returns a list of the Resource Providers used by this Service, which are
+	// registered on-demand when the Provider is configured to only register the Resource Providers in use
+	ResourceProviders() []string
 }
 
 // UntypedServiceRegistration is the interface used for untyped/raw Plugin SDK resources
@@ -32,6 +36,10 @@ type UntypedServiceRegistration interface {
 	// WebsiteCategories returns a list of categories which can be used for the sidebar
 	WebsiteCategories() []string
 
+	// ResourceProviders returns a list of the Resource Providers used by this Service, which are
+	// registered on-demand when the Provider is configured to only register the Resource Providers in use
+	ResourceProviders() []string
+
 	// SupportedDataSources returns the supported Data Sources supported by this Service
 	SupportedDataSources() map[string]*schema.Resource
 
@@ -0,0 +1,301 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+// StateUpgradeData defines the current Schema Version for a Resource
+// and the StateUpgrade's used to get there from each previous version
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the previous Schema Version to the StateUpgrade
+	// which upgrades the State from that version to the next version
+	Upgraders map[int]StateUpgrade
+}
+
+// StateUpgrade is an interface defining an upgrade of the State from
+// one Schema Version to the next
+type StateUpgrade interface {
+	// Schema is a point-in-time copy of the Schema used by the previous Schema Version
+	// NOTE: this needs to be a copy rather than a reference to the current Schema
+	// since the current Schema can (and likely will) change over time
+	Schema() map[string]*schema.Schema
+
+	// UpgradeFunc returns the function which upgrades the State to the next Schema Version
+	UpgradeFunc() StateUpgradeFunc
+}
+
+// StateUpgradeFunc takes the existing State (as decoded from JSON) and returns the
+// upgraded State for the next Schema Version
+type StateUpgradeFunc func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error)
+
+var _ StateUpgrade = ModelStateUpgrade{}
+
+// ModelStateUpgrade is a StateUpgrade which decodes the existing State into the Model
+// Object used by the previous Schema Version, calls the Upgrade function and then
+// encodes the Model Object returned from that into the State
+//
+// This allows the Upgrade function to work with the Model Objects for both the previous and
+// next Schema Versions, rather than the raw State
+type ModelStateUpgrade struct {
+	// PreviousSchema is a point-in-time copy of the Schema used by the previous Schema Version
+	PreviousSchema map[string]*schema.Schema
+
+	// PreviousModel is an instance of the Model Object used by the previous Schema Version
+	PreviousModel interface{}
+
+	// Upgrade is passed a pointer to the PreviousModel containing the existing State
+	// and returns the Model Object for the next Schema Version
+	Upgrade func(ctx context.Context, input interface{}) (interface{}, error)
+}
+
+// Schema returns the Schema used by the previous Schema Version
+func (u ModelStateUpgrade) Schema() map[string]*schema.Schema {
+	return u.PreviousSchema
+}
+
+// UpgradeFunc returns a StateUpgradeFunc which upgrades the State using the Model Objects
+func (u ModelStateUpgrade) UpgradeFunc() StateUpgradeFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		if u.PreviousModel == nil {
+			return nil, fmt.Errorf("`PreviousModel` must be specified")
+		}
+		if u.Upgrade == nil {
+			return nil, fmt.Errorf("`Upgrade` must be specified")
+		}
+
+		previousModelType := reflect.TypeOf(u.PreviousModel)
+		if previousModelType.Kind() == reflect.Ptr {
+			previousModelType = previousModelType.Elem()
+		}
+		input := reflect.New(previousModelType).Interface()
+
+		retriever := rawStateRetriever{
+			schema: u.PreviousSchema,
+			state:  rawState,
+		}
+		if err := decodeReflectedType(input, retriever, NullLogger{}); err != nil {
+			return nil, fmt.Errorf("decoding existing State into %T: %+v", input, err)
+		}
+
+		output, err := u.Upgrade(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if output == nil {
+			return nil, fmt.Errorf("the Upgrade function returned a nil Model Object")
+		}
+
+		outputVal := reflect.Indirect(reflect.ValueOf(output))
+		serialized, err := recurse(outputVal.Type(), outputVal, outputVal.Type().Name(), NullLogger{})
+		if err != nil {
+			return nil, fmt.Errorf("encoding %T into the State: %+v", output, err)
+		}
+
+		// the Model Objects don't contain the ID or Timeouts, so these need to be retained
+		for _, key := range []string{"id", "timeouts"} {
+			if v, ok := rawState[key]; ok {
+				serialized[key] = v
+			}
+		}
+
+		return serialized, nil
+	}
+}
+
+var _ StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic StateUpgrade which parses the existing Resource ID
+// and replaces it with the canonical Resource ID returned from the Formatter - for example
+// to update the casing of a segment (e.g. `resourcegroups` -> `resourceGroups`)
+type ResourceIDStateUpgrade struct {
+	// PreviousSchema is a point-in-time copy of the Schema used by the previous Schema Version
+	PreviousSchema map[string]*schema.Schema
+
+	// Parser parses the existing Resource ID, returning a Formatter for the canonical Resource ID
+	// NOTE: this needs to be able to parse both the previous and the canonical format
+	Parser func(input string) (resourceid.Formatter, error)
+}
+
+// Schema returns the Schema used by the previous Schema Version
+func (u ResourceIDStateUpgrade) Schema() map[string]*schema.Schema {
+	return u.PreviousSchema
+}
+
+// UpgradeFunc returns a StateUpgradeFunc which replaces the existing Resource ID with the canonical Resource ID
+func (u ResourceIDStateUpgrade) UpgradeFunc() StateUpgradeFunc {
+	return func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		if u.Parser == nil {
+			return nil, fmt.Errorf("`Parser` must be specified")
+		}
+
+		oldId, ok := rawState["id"].(string)
+		if !ok || oldId == "" {
+			return nil, fmt.Errorf("the existing State doesn't contain a Resource ID")
+		}
+
+		id, err := u.Parser(oldId)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", oldId, err)
+		}
+
+		rawState["id"] = id.ID()
+		return rawState, nil
+	}
+}
+
+// pluginSdkStateUpgraders converts the StateUpgradeData into the StateUpgraders used by the Plugin SDK
+func pluginSdkStateUpgraders(input StateUpgradeData, contextFunc func(meta interface{}) context.Context) ([]schema.StateUpgrader, error) {
+	versions := make([]int, 0)
+	for version := range input.Upgraders {
+		versions = append(versions, version)
+	}
+	sort.Ints(versions)
+
+	upgraders := make([]schema.StateUpgrader, 0)
+	for i, version := range versions {
+		if version >= input.SchemaVersion {
+			return nil, fmt.Errorf("a StateUpgrade is defined for version %d but the SchemaVersion is %d", version, input.SchemaVersion)
+		}
+		if i > 0 && versions[i-1] != version-1 {
+			return nil, fmt.Errorf("the StateUpgrade's must be consecutive but version %d is missing", version-1)
+		}
+
+		upgrade := input.Upgraders[version]
+		if upgrade == nil {
+			return nil, fmt.Errorf("the StateUpgrade for version %d was nil", version)
+		}
+
+		upgradeFunc := upgrade.UpgradeFunc()
+		previousSchema := schema.Resource{
+			Schema: upgrade.Schema(),
+		}
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    previousSchema.CoreConfigSchema().ImpliedType(),
+			Upgrade: func(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+				return upgradeFunc(contextFunc(meta), rawState, meta)
+			},
+		})
+	}
+
+	if len(upgraders) > 0 && versions[len(versions)-1] != input.SchemaVersion-1 {
+		return nil, fmt.Errorf("the StateUpgrade's must be consecutive but version %d is missing", input.SchemaVersion-1)
+	}
+
+	return upgraders, nil
+}
+
+var _ stateRetriever = rawStateRetriever{}
+
+// rawStateRetriever allows the existing State (as decoded from JSON) to be
+// decoded into a Model Object using the Schema it was written with
+type rawStateRetriever struct {
+	schema map[string]*schema.Schema
+	state  map[string]interface{}
+}
+
+func (r rawStateRetriever) Get(key string) interface{} {
+	v, _ := r.GetOkExists(key)
+	return v
+}
+
+func (r rawStateRetriever) GetOk(key string) (interface{}, bool) {
+	v, exists := r.GetOkExists(key)
+	if !exists {
+		return v, false
+	}
+
+	// matching the Plugin SDK, zero values are considered to not be set
+	return v, !reflect.ValueOf(v).IsZero()
+}
+
+func (r rawStateRetriever) GetOkExists(key string) (interface{}, bool) {
+	v, exists := r.state[key]
+	if !exists || v == nil {
+		return nil, false
+	}
+
+	if s, ok := r.schema[key]; ok {
+		return normalizeRawStateValue(s, v), true
+	}
+
+	return v, true
+}
+
+// normalizeRawStateValue converts the JSON types used in the raw State into the
+// types returned by the Plugin SDK (for example `float64` -> `int`)
+func normalizeRawStateValue(s *schema.Schema, input interface{}) interface{} {
+	if input == nil {
+		return nil
+	}
+
+	switch s.Type {
+	case schema.TypeInt:
+		if v, ok := input.(float64); ok {
+			return int(v)
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		items, ok := input.([]interface{})
+		if !ok {
+			return input
+		}
+
+		output := make([]interface{}, 0)
+		for _, item := range items {
+			switch elem := s.Elem.(type) {
+			case *schema.Schema:
+				output = append(output, normalizeRawStateValue(elem, item))
+
+			case *schema.Resource:
+				raw, ok := item.(map[string]interface{})
+				if !ok {
+					output = append(output, item)
+					continue
+				}
+
+				nested := make(map[string]interface{})
+				for k, v := range raw {
+					if nestedSchema, ok := elem.Schema[k]; ok {
+						nested[k] = normalizeRawStateValue(nestedSchema, v)
+						continue
+					}
+					nested[k] = v
+				}
+				output = append(output, nested)
+
+			default:
+				output = append(output, item)
+			}
+		}
+		return output
+
+	case schema.TypeMap:
+		raw, ok := input.(map[string]interface{})
+		if !ok {
+			return input
+		}
+
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			return input
+		}
+
+		output := make(map[string]interface{})
+		for k, v := range raw {
+			output[k] = normalizeRawStateValue(elem, v)
+		}
+		return output
+	}
+
+	return input
+}
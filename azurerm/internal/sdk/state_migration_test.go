@@ -0,0 +1,281 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type stateMigrationModelV0 struct {
+	Name     string              `tfschema:"name"`
+	Count    int                 `tfschema:"count"`
+	State    string              `tfschema:"state"`
+	Networks []stateMigrationNet `tfschema:"network"`
+}
+
+type stateMigrationModelV1 struct {
+	Name     string              `tfschema:"name"`
+	Count    int                 `tfschema:"count"`
+	Enabled  bool                `tfschema:"enabled"`
+	Networks []stateMigrationNet `tfschema:"network"`
+}
+
+type stateMigrationNet struct {
+	Name string `tfschema:"name"`
+	Port int    `tfschema:"port"`
+}
+
+func stateMigrationSchemaV0() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"network": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"port": {
+						Type:     schema.TypeInt,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestModelStateUpgrade(t *testing.T) {
+	upgrade := ModelStateUpgrade{
+		PreviousSchema: stateMigrationSchemaV0(),
+		PreviousModel:  stateMigrationModelV0{},
+		Upgrade: func(ctx context.Context, input interface{}) (interface{}, error) {
+			old := input.(*stateMigrationModelV0)
+			return stateMigrationModelV1{
+				Name:     old.Name,
+				Count:    old.Count,
+				Enabled:  strings.EqualFold(old.State, "enabled"),
+				Networks: old.Networks,
+			}, nil
+		},
+	}
+
+	// the raw state is decoded from JSON, so numbers are float64's
+	input := map[string]interface{}{
+		"id":    "/some/id",
+		"name":  "example",
+		"count": float64(3),
+		"state": "Enabled",
+		"network": []interface{}{
+			map[string]interface{}{
+				"name": "first",
+				"port": float64(443),
+			},
+		},
+	}
+	actual, err := upgrade.UpgradeFunc()(context.TODO(), input, nil)
+	if err != nil {
+		t.Fatalf("upgrading: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"id":      "/some/id",
+		"name":    "example",
+		"count":   int64(3),
+		"enabled": true,
+		"network": []interface{}{
+			map[string]interface{}{
+				"name": "first",
+				"port": int64(443),
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestModelStateUpgradeError(t *testing.T) {
+	upgrade := ModelStateUpgrade{
+		PreviousSchema: stateMigrationSchemaV0(),
+		PreviousModel:  stateMigrationModelV0{},
+		Upgrade: func(ctx context.Context, input interface{}) (interface{}, error) {
+			return nil, fmt.Errorf("nope")
+		},
+	}
+
+	if _, err := upgrade.UpgradeFunc()(context.TODO(), map[string]interface{}{"name": "example"}, nil); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+type stateMigrationId struct {
+	ResourceGroup string
+	Name          string
+}
+
+func (id stateMigrationId) ID() string {
+	return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/%s/providers/Microsoft.Example/things/%s", id.ResourceGroup, id.Name)
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	upgrade := ResourceIDStateUpgrade{
+		PreviousSchema: stateMigrationSchemaV0(),
+		Parser: func(input string) (resourceid.Formatter, error) {
+			segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+			if len(segments) != 8 {
+				return nil, fmt.Errorf("expected 8 segments but got %d", len(segments))
+			}
+			return stateMigrationId{
+				ResourceGroup: segments[3],
+				Name:          segments[7],
+			}, nil
+		},
+	}
+
+	testData := []struct {
+		input       string
+		expected    string
+		shouldError bool
+	}{
+		{
+			input:       "",
+			shouldError: true,
+		},
+		{
+			input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			shouldError: true,
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Example/things/thing1",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+		},
+		{
+			input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+			expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Example/things/thing1",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual, err := upgrade.UpgradeFunc()(context.TODO(), map[string]interface{}{"id": v.input}, nil)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("upgrading: %+v", err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual["id"].(string) != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual["id"])
+		}
+	}
+}
+
+func TestPluginSdkStateUpgraders(t *testing.T) {
+	upgrade := ResourceIDStateUpgrade{
+		PreviousSchema: stateMigrationSchemaV0(),
+	}
+	ctxFunc := func(_ interface{}) context.Context {
+		return context.TODO()
+	}
+
+	testData := []struct {
+		name        string
+		input       StateUpgradeData
+		expected    int
+		shouldError bool
+	}{
+		{
+			name: "no upgraders",
+			input: StateUpgradeData{
+				SchemaVersion: 0,
+			},
+			expected: 0,
+		},
+		{
+			name: "single upgrader",
+			input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+				},
+			},
+			expected: 1,
+		},
+		{
+			name: "upgrader for the current version",
+			input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "non-consecutive upgraders",
+			input: StateUpgradeData{
+				SchemaVersion: 3,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					2: upgrade,
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "missing latest upgrader",
+			input: StateUpgradeData{
+				SchemaVersion: 3,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			shouldError: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual, err := pluginSdkStateUpgraders(v.input, ctxFunc)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("building: %+v", err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if len(actual) != v.expected {
+			t.Fatalf("expected %d upgraders but got %d", v.expected, len(actual))
+		}
+	}
+}
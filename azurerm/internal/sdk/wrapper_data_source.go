@@ -19,7 +19,9 @@ type DataSourceWrapper struct {
 func NewDataSourceWrapper(dataSource DataSource) DataSourceWrapper {
 	return DataSourceWrapper{
 		dataSource: dataSource,
-		logger:     ConsoleLogger{},
+		logger: ConsoleLogger{}.WithFields(LogFields{
+			LogFieldResourceType: dataSource.ResourceType(),
+		}),
 	}
 }
 
@@ -34,6 +36,15 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	if err := ValidateModelObject(&modelObj); err != nil {
 		return nil, fmt.Errorf("validating model for %q: %+v", rw.dataSource.ResourceType(), err)
 	}
+	if err := ValidateModelObjectMatchesSchema(&modelObj, *resourceSchema); err != nil {
+		return nil, fmt.Errorf("validating model for %q matches the schema: %+v", rw.dataSource.ResourceType(), err)
+	}
+	if v, ok := rw.dataSource.(listDataSource); ok {
+		resultModelObj := v.dataSource.ResultModelObject()
+		if err := ValidateModelObjectMatchesSchema(&resultModelObj, v.dataSource.ResultAttributes()); err != nil {
+			return nil, fmt.Errorf("validating result model for %q matches the schema: %+v", rw.dataSource.ResourceType(), err)
+		}
+	}
 
 	var d = func(duration time.Duration) *time.Duration {
 		return &duration
@@ -42,7 +53,7 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "read"))
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.dataSource.Read().Func(wrappedCtx, metaData)
@@ -45,16 +45,48 @@ func combineSchema(arguments map[string]*schema.Schema, attributes map[string]*s
 	return &out, nil
 }
 
-func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) (context.Context, ResourceMetaData) {
+// resourceState is either the ResourceData or (during a CustomizeDiff) the ResourceDiff for this Resource
+type resourceState interface {
+	Id() string
+}
+
+func runArgs(d resourceState, meta interface{}, logger Logger) (context.Context, ResourceMetaData) {
 	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
 	stopContext := meta.(*clients.Client).StopContext
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
-		Client:                   client,
-		Logger:                   logger,
-		ResourceData:             d,
+		Client: client,
+		Logger: logger.WithFields(LogFields{
+			LogFieldCorrelationRequestID: client.CorrelationRequestID,
+			LogFieldResourceID:           d.Id(),
+		}),
 		serializationDebugLogger: NullLogger{},
 	}
 
+	switch v := d.(type) {
+	case *schema.ResourceData:
+		metaData.ResourceData = v
+	case *schema.ResourceDiff:
+		metaData.ResourceDiff = v
+	}
+
 	return stopContext, metaData
 }
+
+// stateUpgradeContext returns the Context which should be used during a State Upgrade
+// NOTE: the Plugin SDK doesn't pass a Context into the State Upgraders, so the Provider's
+// Stop Context is used where it's available
+func stateUpgradeContext(meta interface{}) context.Context {
+	if client, ok := meta.(*clients.Client); ok && client != nil && client.StopContext != nil {
+		return client.StopContext
+	}
+
+	return context.Background()
+}
+
+// operationLogger returns a Logger which includes the specified Operation in each message
+func operationLogger(logger Logger, operation string) Logger {
+	return logger.WithFields(LogFields{
+		LogFieldOperation: operation,
+	})
+}
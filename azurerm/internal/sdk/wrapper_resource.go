@@ -1,6 +1,7 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -19,7 +20,9 @@ type ResourceWrapper struct {
 // NewResourceWrapper returns a ResourceWrapper for this Resource implementation
 func NewResourceWrapper(resource Resource) ResourceWrapper {
 	return ResourceWrapper{
-		logger:   ConsoleLogger{},
+		logger: ConsoleLogger{}.WithFields(LogFields{
+			LogFieldResourceType: resource.ResourceType(),
+		}),
 		resource: resource,
 	}
 }
@@ -35,6 +38,9 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	if err := ValidateModelObject(&modelObj); err != nil {
 		return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
 	}
+	if err := ValidateModelObjectMatchesSchema(&modelObj, *resourceSchema); err != nil {
+		return nil, fmt.Errorf("validating model for %q matches the schema: %+v", rw.resource.ResourceType(), err)
+	}
 
 	var d = func(duration time.Duration) *time.Duration {
 		return &duration
@@ -44,7 +50,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "create"))
 			wrappedCtx, cancel := timeouts.ForCreate(ctx, d)
 			defer cancel()
 			err := rw.resource.Create().Func(wrappedCtx, metaData)
@@ -59,13 +65,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "read"))
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.resource.Read().Func(wrappedCtx, metaData)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "delete"))
 			wrappedCtx, cancel := timeouts.ForDelete(ctx, d)
 			defer cancel()
 			return rw.resource.Delete().Func(wrappedCtx, metaData)
@@ -76,7 +82,12 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			Read:   d(rw.resource.Read().Timeout),
 			Delete: d(rw.resource.Delete().Timeout),
 		},
-		Importer: azSchema.ValidateResourceIDPriorToImportThen(func(id string) error {
+		Importer: azSchema.ValidateResourceIDPriorToImportThen(func(input string) error {
+			id, err := rw.normalizeID(input)
+			if err != nil {
+				return err
+			}
+
 			fn := rw.resource.IDValidationFunc()
 			warnings, errors := fn(id, "id")
 			if len(warnings) > 0 {
@@ -94,8 +105,14 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 			return nil
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			id, err := rw.normalizeID(d.Id())
+			if err != nil {
+				return nil, err
+			}
+			d.SetId(id)
+
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				ctx, metaData := runArgs(d, meta, rw.logger)
+				ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "import"))
 				wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 				defer cancel()
 
@@ -115,7 +132,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "update"))
 			wrappedCtx, cancel := timeouts.ForUpdate(ctx, d)
 			defer cancel()
 
@@ -140,8 +157,44 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		upgradeData := v.StateUpgraders()
+		upgraders, err := pluginSdkStateUpgraders(upgradeData, stateUpgradeContext)
+		if err != nil {
+			return nil, fmt.Errorf("building State Upgraders for %q: %+v", rw.resource.ResourceType(), err)
+		}
+
+		resource.SchemaVersion = upgradeData.SchemaVersion
+		resource.StateUpgraders = upgraders
+	}
+
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		if v.CustomizeDiff().Timeout == 0 {
+			return nil, fmt.Errorf("Resource %q must specify a Timeout for the CustomizeDiff function if implementing ResourceWithCustomizeDiff", rw.resource.ResourceType())
+		}
+
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			ctx, metaData := runArgs(d, meta, operationLogger(rw.logger, "customizediff"))
+			wrappedCtx, cancel := context.WithTimeout(ctx, v.CustomizeDiff().Timeout)
+			defer cancel()
+			return v.CustomizeDiff().Func(wrappedCtx, metaData)
+		}
+	}
 
 	return &resource, nil
 }
+
+// normalizeID returns the Resource ID using the canonical casing, where the Resource supports this
+func (rw *ResourceWrapper) normalizeID(input string) (string, error) {
+	v, ok := rw.resource.(ResourceWithIDNormalization)
+	if !ok {
+		return input, nil
+	}
+
+	id, err := v.IDNormalizationFunc()(input)
+	if err != nil {
+		return "", fmt.Errorf("normalizing the Resource ID %q: %+v", input, err)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type normalizedIDResource struct{}
+
+func (normalizedIDResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (normalizedIDResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (normalizedIDResource) ModelObject() interface{} {
+	return struct {
+		Name string `tfschema:"name"`
+	}{}
+}
+
+func (normalizedIDResource) ResourceType() string {
+	return "validator_normalized_id"
+}
+
+func (normalizedIDResource) noop() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			return nil
+		},
+		Timeout: time.Minute,
+	}
+}
+
+func (r normalizedIDResource) Create() ResourceFunc {
+	return r.noop()
+}
+
+func (r normalizedIDResource) Read() ResourceFunc {
+	return r.noop()
+}
+
+func (r normalizedIDResource) Delete() ResourceFunc {
+	return r.noop()
+}
+
+func (normalizedIDResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		if v := input.(string); !strings.HasPrefix(v, "/resourceGroups/") {
+			errors = append(errors, fmt.Errorf("expected %q to use the canonical casing but got %q", key, v))
+		}
+		return
+	}
+}
+
+func (normalizedIDResource) IDNormalizationFunc() IDNormalizationFunc {
+	return func(input string) (string, error) {
+		if !strings.HasPrefix(strings.ToLower(input), "/resourcegroups/") {
+			return "", fmt.Errorf("expected a Resource Group ID")
+		}
+
+		return "/resourceGroups/" + input[len("/resourceGroups/"):], nil
+	}
+}
+
+func TestResourceWrapperImportNormalizesID(t *testing.T) {
+	wrapper := NewResourceWrapper(normalizedIDResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	d := resource.Data(nil)
+	d.SetId("/RESOURCEGROUPS/example")
+	output, err := resource.Importer.State(d, nil)
+	if err != nil {
+		t.Fatalf("importing: %+v", err)
+	}
+	if len(output) != 1 || output[0].Id() != "/resourceGroups/example" {
+		t.Fatalf("expected the ID to be normalized to %q but got %+v", "/resourceGroups/example", output)
+	}
+
+	d = resource.Data(nil)
+	d.SetId("/subscriptions/example")
+	if _, err := resource.Importer.State(d, nil); err == nil {
+		t.Fatalf("expected an error when the ID couldn't be normalized but didn't get one")
+	}
+}
@@ -4,20 +4,53 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
 // required to be used with the Encode and Decode functions
 func ValidateModelObject(input interface{}) error {
-	if reflect.TypeOf(input).Kind() != reflect.Ptr {
-		return fmt.Errorf("need a pointer")
+	objType, objVal, err := modelObjectTypeAndValue(input)
+	if err != nil {
+		return err
+	}
+
+	return validateModelObjectRecursively("", objType, objVal)
+}
+
+// ValidateModelObjectMatchesSchema validates that each `tfschema` tag within the object
+// exists in the specified Schema - and that the type of each field is compatible with
+// the type of the field in the Schema, such that it can be used with Encode and Decode
+func ValidateModelObjectMatchesSchema(input interface{}, resourceSchema map[string]*schema.Schema) error {
+	objType, _, err := modelObjectTypeAndValue(input)
+	if err != nil {
+		return err
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
+	return validateModelObjectMatchesSchemaRecursively("", objType, resourceSchema)
+}
+
+// modelObjectTypeAndValue returns the Type and Value for the Model Object - which
+// is either a pointer to the Model Object or a pointer to an interface containing it
+func modelObjectTypeAndValue(input interface{}) (reflect.Type, reflect.Value, error) {
+	if reflect.TypeOf(input).Kind() != reflect.Ptr {
+		return nil, reflect.Value{}, fmt.Errorf("need a pointer")
+	}
 
-	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
-	return validateModelObjectRecursively("", objType, objVal)
+	if objVal.Kind() == reflect.Interface {
+		if objVal.IsNil() {
+			return nil, reflect.Value{}, fmt.Errorf("the Model Object was nil")
+		}
+		objVal = reflect.Indirect(objVal.Elem())
+	}
+
+	if objVal.Kind() != reflect.Struct {
+		return nil, reflect.Value{}, fmt.Errorf("the Model Object must be a struct but got %s", objVal.Kind())
+	}
+
+	return objVal.Type(), objVal, nil
 }
 
 func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal reflect.Value) (errOut error) {
@@ -54,3 +87,106 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	return nil
 }
+
+func validateModelObjectMatchesSchemaRecursively(prefix string, objType reflect.Type, resourceSchema map[string]*schema.Schema) error {
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		tfschemaTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
+		}
+
+		fieldSchema, exists := resourceSchema[tfschemaTag]
+		if !exists {
+			return fmt.Errorf("field %q references %q which doesn't exist in the schema", fieldName, tfschemaTag)
+		}
+
+		if v, ok := field.Tag.Lookup("computed"); ok && strings.EqualFold(v, "true") {
+			if fieldSchema.Optional || fieldSchema.Required {
+				return fmt.Errorf("field %q is marked as computed but %q is user-configurable in the schema", fieldName, tfschemaTag)
+			}
+		}
+
+		if err := validateModelFieldMatchesSchema(fieldName, field.Type, fieldSchema); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateModelFieldMatchesSchema(fieldName string, fieldType reflect.Type, fieldSchema *schema.Schema) error {
+	switch fieldSchema.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		if !primitiveTypeMatchesSchema(fieldType, fieldSchema.Type) {
+			return fmt.Errorf("field %q is a %s but the schema is a %s", fieldName, fieldType, fieldSchema.Type)
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		if fieldType.Kind() != reflect.Slice {
+			return fmt.Errorf("field %q is a %s but the schema is a %s which requires a slice", fieldName, fieldType, fieldSchema.Type)
+		}
+
+		switch elem := fieldSchema.Elem.(type) {
+		case *schema.Resource:
+			if fieldType.Elem().Kind() != reflect.Struct {
+				return fmt.Errorf("field %q is a %s but the schema is a %s of nested objects which requires a slice of structs", fieldName, fieldType, fieldSchema.Type)
+			}
+
+			return validateModelObjectMatchesSchemaRecursively(fieldName, fieldType.Elem(), elem.Schema)
+
+		case *schema.Schema:
+			if !primitiveTypeMatchesSchema(fieldType.Elem(), elem.Type) {
+				return fmt.Errorf("field %q is a %s but the schema is a %s of %s", fieldName, fieldType, fieldSchema.Type, elem.Type)
+			}
+
+		default:
+			return fmt.Errorf("field %q: the schema for %s's must specify an Elem", fieldName, fieldSchema.Type)
+		}
+
+	case schema.TypeMap:
+		if fieldType.Kind() != reflect.Map || fieldType.Key().Kind() != reflect.String {
+			return fmt.Errorf("field %q is a %s but the schema is a TypeMap which requires a map with string keys", fieldName, fieldType)
+		}
+
+		// the Plugin SDK defaults the values in a Map to Strings when no Elem is specified
+		elemType := schema.TypeString
+		if elem, ok := fieldSchema.Elem.(*schema.Schema); ok {
+			elemType = elem.Type
+		}
+		if !primitiveTypeMatchesSchema(fieldType.Elem(), elemType) {
+			return fmt.Errorf("field %q is a %s but the schema is a TypeMap of %s", fieldName, fieldType, elemType)
+		}
+
+	default:
+		return fmt.Errorf("field %q: unsupported schema type %s", fieldName, fieldSchema.Type)
+	}
+
+	return nil
+}
+
+func primitiveTypeMatchesSchema(fieldType reflect.Type, valueType schema.ValueType) bool {
+	switch valueType {
+	case schema.TypeString:
+		return fieldType.Kind() == reflect.String
+
+	case schema.TypeInt:
+		switch fieldType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return true
+		}
+
+	case schema.TypeFloat:
+		switch fieldType.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return true
+		}
+
+	case schema.TypeBool:
+		return fieldType.Kind() == reflect.Bool
+	}
+
+	return false
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -56,3 +60,168 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectWithinInterface(t *testing.T) {
+	type Person struct {
+		Name string
+	}
+	var obj interface{} = Person{}
+	if err := ValidateModelObject(&obj); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateModelObjectMatchesSchema(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+		Age  int    `tfschema:"age"`
+	}
+	type Person struct {
+		Name      string            `tfschema:"name"`
+		Height    float64           `tfschema:"height"`
+		Enabled   bool              `tfschema:"enabled"`
+		Nicknames []string          `tfschema:"nicknames"`
+		Pets      []Pet             `tfschema:"pet"`
+		Tags      map[string]string `tfschema:"tags"`
+		Output    string            `tfschema:"output" computed:"true"`
+	}
+	personSchema := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"height": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"nicknames": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"pet": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"age": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}
+	}
+
+	testData := []struct {
+		name        string
+		update      func(input map[string]*schema.Schema)
+		shouldError bool
+	}{
+		{
+			name:   "valid",
+			update: func(_ map[string]*schema.Schema) {},
+		},
+		{
+			name: "missing top-level field",
+			update: func(input map[string]*schema.Schema) {
+				delete(input, "height")
+			},
+			shouldError: true,
+		},
+		{
+			name: "missing nested field",
+			update: func(input map[string]*schema.Schema) {
+				delete(input["pet"].Elem.(*schema.Resource).Schema, "age")
+			},
+			shouldError: true,
+		},
+		{
+			name: "mismatched primitive",
+			update: func(input map[string]*schema.Schema) {
+				input["enabled"].Type = schema.TypeString
+			},
+			shouldError: true,
+		},
+		{
+			name: "mismatched nested primitive",
+			update: func(input map[string]*schema.Schema) {
+				input["pet"].Elem.(*schema.Resource).Schema["age"].Type = schema.TypeFloat
+			},
+			shouldError: true,
+		},
+		{
+			name: "slice of primitives for a nested object",
+			update: func(input map[string]*schema.Schema) {
+				input["nicknames"].Elem = &schema.Resource{
+					Schema: map[string]*schema.Schema{},
+				}
+			},
+			shouldError: true,
+		},
+		{
+			name: "slice of strings for a list of ints",
+			update: func(input map[string]*schema.Schema) {
+				input["nicknames"].Elem = &schema.Schema{
+					Type: schema.TypeInt,
+				}
+			},
+			shouldError: true,
+		},
+		{
+			name: "map for a list",
+			update: func(input map[string]*schema.Schema) {
+				input["tags"].Type = schema.TypeList
+			},
+			shouldError: true,
+		},
+		{
+			name: "computed field is user-configurable",
+			update: func(input map[string]*schema.Schema) {
+				input["output"].Optional = true
+			},
+			shouldError: true,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		input := personSchema()
+		v.update(input)
+
+		err := ValidateModelObjectMatchesSchema(&Person{}, input)
+		if err != nil {
+			if v.shouldError {
+				continue
+			}
+
+			t.Fatalf("error: %+v", err)
+		}
+		if v.shouldError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -3,7 +3,10 @@ package parse
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
@@ -110,3 +113,247 @@ func TestServerID(t *testing.T) {
 		}
 	}
 }
+
+func TestServerIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ServerId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+			Expected: &ServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "Server1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+			Expected: &ServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "Server1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.AnalysisServices/SERVERS/Server1",
+			Expected: &ServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "Server1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/ReSoUrCeGrOuPs/resGroup1/PrOvIdErS/Microsoft.AnalysisServices/SeRvErS/Server1",
+			Expected: &ServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				Name:           "Server1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ServerIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestNormalizeServerID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+		},
+
+		{
+			// lower-cased segment names
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+		},
+
+		{
+			// upper-cased segment names
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.AnalysisServices/SERVERS/Server1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+		},
+
+		{
+			// lower-cased segment names and resource provider
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/microsoft.analysisservices/servers/Server1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NormalizeServerID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestServerIDRoundTrip(t *testing.T) {
+	expected := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "Server1")
+
+	actual, err := ServerID(expected.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if *actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+
+	normalized, err := NormalizeServerID(actual.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if normalized != expected.ID() {
+		t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+	}
+}
+
+func TestServerIDInsensitivelyFuzz(t *testing.T) {
+	expected := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "Server1")
+
+	// a fixed seed is used so that any failures are reproducible
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		// the casing of each of the segment names (and the resource provider) is randomised, the values
+		// are case-sensitive so are left as-is
+		components := strings.Split(expected.ID(), "/")
+		for j := 1; j < len(components); j += 2 {
+			indexes := []int{j}
+			if components[j] == "providers" && j+1 < len(components) {
+				indexes = append(indexes, j+1)
+			}
+
+			for _, index := range indexes {
+				chars := []rune(components[index])
+				for k := range chars {
+					if random.Intn(2) == 0 {
+						chars[k] = unicode.ToUpper(chars[k])
+					} else {
+						chars[k] = unicode.ToLower(chars[k])
+					}
+				}
+				components[index] = string(chars)
+			}
+		}
+		input := strings.Join(components, "/")
+		t.Logf("[DEBUG] Testing %q", input)
+
+		actual, err := ServerIDInsensitively(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if *actual != expected {
+			t.Fatalf("Expected %+v but got %+v", expected, *actual)
+		}
+
+		normalized, err := NormalizeServerID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if normalized != expected.ID() {
+			t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+		}
+	}
+}
@@ -73,3 +73,55 @@ func ApiID(input string) (*ApiId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiIDInsensitively parses an Api ID into an ApiId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiIDInsensitively(input string) (*ApiId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("apis"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiID parses the specified Api ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiID(input string) (string, error) {
+	id, err := ApiIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -79,3 +79,58 @@ func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiDiagnosticIDInsensitively parses an ApiDiagnostic ID into an ApiDiagnosticId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiDiagnosticID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiDiagnosticIDInsensitively(input string) (*ApiDiagnosticId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiDiagnosticId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
+		return nil, err
+	}
+	if resourceId.DiagnosticName, err = id.PopSegmentInsensitively("diagnostics"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiDiagnosticID parses the specified ApiDiagnostic ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiDiagnosticID(input string) (string, error) {
+	id, err := ApiDiagnosticIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -67,3 +67,52 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiManagementIDInsensitively parses an ApiManagement ID into an ApiManagementId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiManagementID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiManagementIDInsensitively(input string) (*ApiManagementId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiManagementId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiManagementID parses the specified ApiManagement ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiManagementID(input string) (string, error) {
+	id, err := ApiManagementIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
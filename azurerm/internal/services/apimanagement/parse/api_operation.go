@@ -79,3 +79,58 @@ func ApiOperationID(input string) (*ApiOperationId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiOperationIDInsensitively parses an ApiOperation ID into an ApiOperationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiOperationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiOperationIDInsensitively(input string) (*ApiOperationId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiOperationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
+		return nil, err
+	}
+	if resourceId.OperationName, err = id.PopSegmentInsensitively("operations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiOperationID parses the specified ApiOperation ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiOperationID(input string) (string, error) {
+	id, err := ApiOperationIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
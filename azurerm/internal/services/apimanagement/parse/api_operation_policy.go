@@ -85,3 +85,61 @@ func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiOperationPolicyIDInsensitively parses an ApiOperationPolicy ID into an ApiOperationPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiOperationPolicyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiOperationPolicyIDInsensitively(input string) (*ApiOperationPolicyId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiOperationPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
+		return nil, err
+	}
+	if resourceId.OperationName, err = id.PopSegmentInsensitively("operations"); err != nil {
+		return nil, err
+	}
+	if resourceId.PolicyName, err = id.PopSegmentInsensitively("policies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiOperationPolicyID parses the specified ApiOperationPolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiOperationPolicyID(input string) (string, error) {
+	id, err := ApiOperationPolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
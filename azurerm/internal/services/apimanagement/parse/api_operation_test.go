@@ -3,7 +3,10 @@ package parse
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
@@ -142,3 +145,285 @@ func TestApiOperationID(t *testing.T) {
 		}
 	}
 }
+
+func TestApiOperationIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ApiOperationId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing ServiceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/",
+			Error: true,
+		},
+
+		{
+			// missing value for ServiceName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/",
+			Error: true,
+		},
+
+		{
+			// missing ApiName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/",
+			Error: true,
+		},
+
+		{
+			// missing value for ApiName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/",
+			Error: true,
+		},
+
+		{
+			// missing OperationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/",
+			Error: true,
+		},
+
+		{
+			// missing value for OperationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.ApiManagement/SERVICE/service1/APIS/api1/OPERATIONS/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/SuBsCrIpTiOnS/12345678-1234-9876-4563-123456789012/ReSoUrCeGrOuPs/resGroup1/PrOvIdErS/Microsoft.ApiManagement/SeRvIcE/service1/ApIs/api1/OpErAtIoNs/operation1",
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "resGroup1",
+				ServiceName:    "service1",
+				ApiName:        "api1",
+				OperationName:  "operation1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ApiOperationIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.ServiceName != v.Expected.ServiceName {
+			t.Fatalf("Expected %q but got %q for ServiceName", v.Expected.ServiceName, actual.ServiceName)
+		}
+		if actual.ApiName != v.Expected.ApiName {
+			t.Fatalf("Expected %q but got %q for ApiName", v.Expected.ApiName, actual.ApiName)
+		}
+		if actual.OperationName != v.Expected.OperationName {
+			t.Fatalf("Expected %q but got %q for OperationName", v.Expected.OperationName, actual.OperationName)
+		}
+	}
+}
+
+func TestNormalizeApiOperationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+		},
+
+		{
+			// lower-cased segment names
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+		},
+
+		{
+			// upper-cased segment names
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/Microsoft.ApiManagement/SERVICE/service1/APIS/api1/OPERATIONS/operation1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+		},
+
+		{
+			// lower-cased segment names and resource provider
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/resGroup1/providers/microsoft.apimanagement/service/service1/apis/api1/operations/operation1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NormalizeApiOperationID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestApiOperationIDRoundTrip(t *testing.T) {
+	expected := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1")
+
+	actual, err := ApiOperationID(expected.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if *actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+
+	normalized, err := NormalizeApiOperationID(actual.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if normalized != expected.ID() {
+		t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+	}
+}
+
+func TestApiOperationIDInsensitivelyFuzz(t *testing.T) {
+	expected := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1")
+
+	// a fixed seed is used so that any failures are reproducible
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		// the casing of each of the segment names (and the resource provider) is randomised, the values
+		// are case-sensitive so are left as-is
+		components := strings.Split(expected.ID(), "/")
+		for j := 1; j < len(components); j += 2 {
+			indexes := []int{j}
+			if components[j] == "providers" && j+1 < len(components) {
+				indexes = append(indexes, j+1)
+			}
+
+			for _, index := range indexes {
+				chars := []rune(components[index])
+				for k := range chars {
+					if random.Intn(2) == 0 {
+						chars[k] = unicode.ToUpper(chars[k])
+					} else {
+						chars[k] = unicode.ToLower(chars[k])
+					}
+				}
+				components[index] = string(chars)
+			}
+		}
+		input := strings.Join(components, "/")
+		t.Logf("[DEBUG] Testing %q", input)
+
+		actual, err := ApiOperationIDInsensitively(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if *actual != expected {
+			t.Fatalf("Expected %+v but got %+v", expected, *actual)
+		}
+
+		normalized, err := NormalizeApiOperationID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if normalized != expected.ID() {
+			t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+		}
+	}
+}
@@ -79,3 +79,58 @@ func ApiSchemaID(input string) (*ApiSchemaId, error) {
 
 	return &resourceId, nil
 }
+
+// ApiSchemaIDInsensitively parses an ApiSchema ID into an ApiSchemaId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApiSchemaID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApiSchemaIDInsensitively(input string) (*ApiSchemaId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiSchemaId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
+		return nil, err
+	}
+	if resourceId.SchemaName, err = id.PopSegmentInsensitively("schemas"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeApiSchemaID parses the specified ApiSchema ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApiSchemaID(input string) (string, error) {
+	id, err := ApiSchemaIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
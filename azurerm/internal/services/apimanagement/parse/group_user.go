@@ -79,3 +79,58 @@ func GroupUserID(input string) (*GroupUserId, error) {
 
 	return &resourceId, nil
 }
+
+// GroupUserIDInsensitively parses an GroupUser ID into an GroupUserId struct, insensitively
+// This should only be used to parse an ID for rewriting, the GroupUserID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func GroupUserIDInsensitively(input string) (*GroupUserId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := GroupUserId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.GroupName, err = id.PopSegmentInsensitively("groups"); err != nil {
+		return nil, err
+	}
+	if resourceId.UserName, err = id.PopSegmentInsensitively("users"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeGroupUserID parses the specified GroupUser ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeGroupUserID(input string) (string, error) {
+	id, err := GroupUserIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func OpenIDConnectProviderID(input string) (*OpenIDConnectProviderId, error) {
 
 	return &resourceId, nil
 }
+
+// OpenIDConnectProviderIDInsensitively parses an OpenIDConnectProvider ID into an OpenIDConnectProviderId struct, insensitively
+// This should only be used to parse an ID for rewriting, the OpenIDConnectProviderID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func OpenIDConnectProviderIDInsensitively(input string) (*OpenIDConnectProviderId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := OpenIDConnectProviderId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("openidConnectProviders"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeOpenIDConnectProviderID parses the specified OpenIDConnectProvider ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeOpenIDConnectProviderID(input string) (string, error) {
+	id, err := OpenIDConnectProviderIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
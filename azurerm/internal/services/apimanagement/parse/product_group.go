@@ -79,3 +79,58 @@ func ProductGroupID(input string) (*ProductGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// ProductGroupIDInsensitively parses an ProductGroup ID into an ProductGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ProductGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ProductGroupIDInsensitively(input string) (*ProductGroupId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ProductGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ProductName, err = id.PopSegmentInsensitively("products"); err != nil {
+		return nil, err
+	}
+	if resourceId.GroupName, err = id.PopSegmentInsensitively("groups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeProductGroupID parses the specified ProductGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeProductGroupID(input string) (string, error) {
+	id, err := ProductGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
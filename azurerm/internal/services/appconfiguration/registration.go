@@ -18,6 +18,13 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviders returns a list of the Resource Providers used by this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.AppConfiguration",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
@@ -73,3 +73,55 @@ func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SmartDetectionRuleIDInsensitively parses an SmartDetectionRule ID into an SmartDetectionRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SmartDetectionRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SmartDetectionRuleIDInsensitively(input string) (*SmartDetectionRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SmartDetectionRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ComponentName, err = id.PopSegmentInsensitively("components"); err != nil {
+		return nil, err
+	}
+	if resourceId.SmartDetectionRuleName, err = id.PopSegmentInsensitively("SmartDetectionRule"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSmartDetectionRuleID parses the specified SmartDetectionRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSmartDetectionRuleID(input string) (string, error) {
+	id, err := SmartDetectionRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
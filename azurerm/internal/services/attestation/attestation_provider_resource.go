@@ -168,7 +168,7 @@ func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	updateParams := attestation.ServicePatchParams{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateParams.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
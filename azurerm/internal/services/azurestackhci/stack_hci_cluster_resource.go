@@ -162,7 +162,7 @@ func resourceArmStackHCIClusterUpdate(d *schema.ResourceData, meta interface{})
 
 	cluster := azurestackhci.ClusterUpdate{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		cluster.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
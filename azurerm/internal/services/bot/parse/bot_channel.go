@@ -73,3 +73,55 @@ func BotChannelID(input string) (*BotChannelId, error) {
 
 	return &resourceId, nil
 }
+
+// BotChannelIDInsensitively parses an BotChannel ID into an BotChannelId struct, insensitively
+// This should only be used to parse an ID for rewriting, the BotChannelID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func BotChannelIDInsensitively(input string) (*BotChannelId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BotChannelId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.BotServiceName, err = id.PopSegmentInsensitively("botServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ChannelName, err = id.PopSegmentInsensitively("channels"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeBotChannelID parses the specified BotChannel ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeBotChannelID(input string) (string, error) {
+	id, err := BotChannelIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 
 	return &resourceId, nil
 }
+
+// BotConnectionIDInsensitively parses an BotConnection ID into an BotConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the BotConnectionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func BotConnectionIDInsensitively(input string) (*BotConnectionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BotConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.BotServiceName, err = id.PopSegmentInsensitively("botServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ConnectionName, err = id.PopSegmentInsensitively("connections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeBotConnectionID parses the specified BotConnection ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeBotConnectionID(input string) (string, error) {
+	id, err := BotConnectionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
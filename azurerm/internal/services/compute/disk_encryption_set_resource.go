@@ -217,7 +217,7 @@ func resourceDiskEncryptionSetUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	update := compute.DiskEncryptionSetUpdate{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -314,7 +314,7 @@ func resourceManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
 		DiskUpdateProperties: &compute.DiskUpdateProperties{},
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		diskUpdate.Tags = tags.Expand(t)
 	}
@@ -73,3 +73,55 @@ func DedicatedHostID(input string) (*DedicatedHostId, error) {
 
 	return &resourceId, nil
 }
+
+// DedicatedHostIDInsensitively parses an DedicatedHost ID into an DedicatedHostId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DedicatedHostID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DedicatedHostIDInsensitively(input string) (*DedicatedHostId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DedicatedHostId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.HostGroupName, err = id.PopSegmentInsensitively("hostGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.HostName, err = id.PopSegmentInsensitively("hosts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDedicatedHostID parses the specified DedicatedHost ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDedicatedHostID(input string) (string, error) {
+	id, err := DedicatedHostIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func SharedImageID(input string) (*SharedImageId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageIDInsensitively parses an SharedImage ID into an SharedImageId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SharedImageID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SharedImageIDInsensitively(input string) (*SharedImageId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SharedImageId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImageName, err = id.PopSegmentInsensitively("images"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSharedImageID parses the specified SharedImage ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSharedImageID(input string) (string, error) {
+	id, err := SharedImageIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -67,3 +67,52 @@ func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageGalleryIDInsensitively parses an SharedImageGallery ID into an SharedImageGalleryId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SharedImageGalleryID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SharedImageGalleryIDInsensitively(input string) (*SharedImageGalleryId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SharedImageGalleryId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSharedImageGalleryID parses the specified SharedImageGallery ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSharedImageGalleryID(input string) (string, error) {
+	id, err := SharedImageGalleryIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
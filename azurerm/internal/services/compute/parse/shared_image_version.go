@@ -79,3 +79,58 @@ func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
 
 	return &resourceId, nil
 }
+
+// SharedImageVersionIDInsensitively parses an SharedImageVersion ID into an SharedImageVersionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SharedImageVersionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SharedImageVersionIDInsensitively(input string) (*SharedImageVersionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SharedImageVersionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
+		return nil, err
+	}
+	if resourceId.ImageName, err = id.PopSegmentInsensitively("images"); err != nil {
+		return nil, err
+	}
+	if resourceId.VersionName, err = id.PopSegmentInsensitively("versions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSharedImageVersionID parses the specified SharedImageVersion ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSharedImageVersionID(input string) (string, error) {
+	id, err := SharedImageVersionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error)
 
 	return &resourceId, nil
 }
+
+// VirtualMachineExtensionIDInsensitively parses an VirtualMachineExtension ID into an VirtualMachineExtensionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the VirtualMachineExtensionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func VirtualMachineExtensionIDInsensitively(input string) (*VirtualMachineExtensionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineExtensionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineName, err = id.PopSegmentInsensitively("virtualMachines"); err != nil {
+		return nil, err
+	}
+	if resourceId.ExtensionName, err = id.PopSegmentInsensitively("extensions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeVirtualMachineExtensionID parses the specified VirtualMachineExtension ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeVirtualMachineExtensionID(input string) (string, error) {
+	id, err := VirtualMachineExtensionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
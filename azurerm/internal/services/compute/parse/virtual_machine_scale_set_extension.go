@@ -73,3 +73,55 @@ func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExt
 
 	return &resourceId, nil
 }
+
+// VirtualMachineScaleSetExtensionIDInsensitively parses an VirtualMachineScaleSetExtension ID into an VirtualMachineScaleSetExtensionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the VirtualMachineScaleSetExtensionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func VirtualMachineScaleSetExtensionIDInsensitively(input string) (*VirtualMachineScaleSetExtensionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualMachineScaleSetExtensionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegmentInsensitively("virtualMachineScaleSets"); err != nil {
+		return nil, err
+	}
+	if resourceId.ExtensionName, err = id.PopSegmentInsensitively("extensions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeVirtualMachineScaleSetExtensionID parses the specified VirtualMachineScaleSetExtension ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeVirtualMachineScaleSetExtensionID(input string) (string, error) {
+	id, err := VirtualMachineScaleSetExtensionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
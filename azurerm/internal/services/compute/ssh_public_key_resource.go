@@ -181,7 +181,7 @@ func resourceSshPublicKeyUpdate(d *schema.ResourceData, meta interface{}) error
 		SSHPublicKeyResourceProperties: &props,
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		update.Tags = tags.Expand(tagsRaw)
 	}
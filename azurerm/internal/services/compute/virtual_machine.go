@@ -2,15 +2,13 @@ package compute
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-06-01/compute"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
-	msiparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
-	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -67,111 +65,50 @@ func flattenVirtualMachineAdditionalCapabilities(input *compute.AdditionalCapabi
 	}
 }
 
-func virtualMachineIdentitySchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"type": {
-					Type:     schema.TypeString,
-					Required: true,
-					ValidateFunc: validation.StringInSlice([]string{
-						string(compute.ResourceIdentityTypeSystemAssigned),
-						string(compute.ResourceIdentityTypeUserAssigned),
-						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
-					}, false),
-				},
+type virtualMachineIdentity = identity.SystemAssignedUserAssigned
 
-				"identity_ids": {
-					Type:     schema.TypeSet,
-					Optional: true,
-					Elem: &schema.Schema{
-						Type:         schema.TypeString,
-						ValidateFunc: msivalidate.UserAssignedIdentityID,
-					},
-				},
-
-				"principal_id": {
-					Type:     schema.TypeString,
-					Computed: true,
-				},
-
-				"tenant_id": {
-					Type:     schema.TypeString,
-					Computed: true,
-				},
-			},
-		},
-	}
+func virtualMachineIdentitySchema() *schema.Schema {
+	return virtualMachineIdentity{}.Schema()
 }
 
 func expandVirtualMachineIdentity(input []interface{}) (*compute.VirtualMachineIdentity, error) {
-	if len(input) == 0 {
-		// TODO: Does this want to be this, or nil?
-		return &compute.VirtualMachineIdentity{
-			Type: compute.ResourceIdentityTypeNone,
-		}, nil
-	}
-
-	raw := input[0].(map[string]interface{})
-
-	identity := compute.VirtualMachineIdentity{
-		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	config, err := virtualMachineIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
 	}
 
-	identityIdsRaw := raw["identity_ids"].(*schema.Set).List()
-	identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
-	for _, v := range identityIdsRaw {
-		identityIds[v.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+	// when the block is omitted the type is `None` - TODO: Does this want to be this, or nil?
+	output := compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(config.Type),
 	}
 
-	if len(identityIds) > 0 {
-		if identity.Type != compute.ResourceIdentityTypeUserAssigned && identity.Type != compute.ResourceIdentityTypeSystemAssignedUserAssigned {
-			return nil, fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+	if config.UserAssignedIdentityIds != nil {
+		identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+		for _, id := range *config.UserAssignedIdentityIds {
+			identityIds[id] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
 		}
-
-		identity.UserAssignedIdentities = identityIds
+		output.UserAssignedIdentities = identityIds
 	}
 
-	return &identity, nil
+	return &output, nil
 }
 
-func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) ([]interface{}, error) {
-	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
-		return []interface{}{}, nil
-	}
-
-	identityIds := make([]string, 0)
-	if input.UserAssignedIdentities != nil {
+func flattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	var config *identity.ExpandedConfig
+	if input != nil {
+		identityIds := make([]string, 0)
 		for key := range input.UserAssignedIdentities {
-			parsedId, err := msiparse.UserAssignedIdentityID(key)
-			if err != nil {
-				return nil, err
-			}
-			identityIds = append(identityIds, parsedId.ID())
+			identityIds = append(identityIds, key)
 		}
-	}
 
-	principalId := ""
-	if input.PrincipalID != nil {
-		principalId = *input.PrincipalID
-	}
-
-	tenantId := ""
-	if input.TenantID != nil {
-		tenantId = *input.TenantID
+		config = &identity.ExpandedConfig{
+			Type:                    string(input.Type),
+			PrincipalId:             input.PrincipalID,
+			TenantId:                input.TenantID,
+			UserAssignedIdentityIds: &identityIds,
+		}
 	}
-
-	return []interface{}{
-		map[string]interface{}{
-			"type":         string(input.Type),
-			"identity_ids": identityIds,
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-		},
-	}, nil
+	return virtualMachineIdentity{}.Flatten(config)
 }
 
 func expandVirtualMachineNetworkInterfaceIDs(input []interface{}) []compute.NetworkInterfaceReference {
@@ -84,11 +84,7 @@ func dataSourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*resp.ID)
 
-	identity, err := flattenVirtualMachineIdentity(resp.Identity)
-	if err != nil {
-		return err
-	}
-	if err := d.Set("identity", identity); err != nil {
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
@@ -82,11 +82,7 @@ func dataSourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface
 	}
 	d.SetId(*resp.ID)
 
-	identity, err := FlattenVirtualMachineScaleSetIdentity(resp.Identity)
-	if err != nil {
-		return err
-	}
-	if err := d.Set("identity", identity); err != nil {
+	if err := d.Set("identity", FlattenVirtualMachineScaleSetIdentity(resp.Identity)); err != nil {
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
@@ -512,7 +512,7 @@ func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interf
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		props.Tags = tags.Expand(t)
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
 	computeValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/kubernetes"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/parse"
@@ -811,7 +812,11 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if len(managedClusterIdentityRaw) > 0 {
-		parameters.Identity = expandKubernetesClusterManagedClusterIdentity(managedClusterIdentityRaw)
+		managedClusterIdentity, err := expandKubernetesClusterManagedClusterIdentity(managedClusterIdentityRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = managedClusterIdentity
 		parameters.ManagedClusterProperties.ServicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
 			ClientID: utils.String("msi"),
 		}
@@ -1078,7 +1083,7 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		existing.ManagedClusterProperties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
 		existing.Tags = tags.Expand(t)
@@ -1094,7 +1099,11 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 	if d.HasChange("identity") {
 		updateCluster = true
 		managedClusterIdentityRaw := d.Get("identity").([]interface{})
-		existing.Identity = expandKubernetesClusterManagedClusterIdentity(managedClusterIdentityRaw)
+		managedClusterIdentity, err := expandKubernetesClusterManagedClusterIdentity(managedClusterIdentityRaw)
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		existing.Identity = managedClusterIdentity
 	}
 
 	if d.HasChange("sku_tier") {
@@ -1418,7 +1427,7 @@ func flattenKubernetesClusterIdentityProfile(profile map[string]*containerservic
 
 		userAssignedIdentityId := ""
 		if resourceid := kubeletidentity.ResourceID; resourceid != nil {
-			parsedId, err := msiparse.UserAssignedIdentityID(*resourceid)
+			parsedId, err := msiparse.UserAssignedIdentityIDInsensitively(*resourceid)
 			if err != nil {
 				return nil, err
 			}
@@ -1775,29 +1784,50 @@ func expandKubernetesClusterRoleBasedAccessControl(input []interface{}, provider
 	return rbacEnabled, aad, nil
 }
 
-func expandKubernetesClusterManagedClusterIdentity(input []interface{}) *containerservice.ManagedClusterIdentity {
-	if len(input) == 0 || input[0] == nil {
-		return &containerservice.ManagedClusterIdentity{
-			Type: containerservice.ResourceIdentityTypeNone,
+// kubernetesClusterIdentity is used to expand and flatten the `identity` block, which only supports
+// a single User Assigned Identity (exposed as `user_assigned_identity_id` rather than `identity_ids`)
+type kubernetesClusterIdentity = identity.SystemAssignedUserAssigned
+
+func expandKubernetesClusterManagedClusterIdentity(input []interface{}) (*containerservice.ManagedClusterIdentity, error) {
+	model := make([]identity.ModelSystemAssignedUserAssigned, 0)
+	if len(input) > 0 && input[0] != nil {
+		raw := input[0].(map[string]interface{})
+		identityType := raw["type"].(string)
+
+		// the User Assigned Identity is only used when the `type` is `UserAssigned`
+		identityIds := make([]string, 0)
+		if identityType == string(containerservice.ResourceIdentityTypeUserAssigned) {
+			userAssignedIdentityId := raw["user_assigned_identity_id"].(string)
+			if userAssignedIdentityId == "" {
+				return nil, fmt.Errorf("`user_assigned_identity_id` must be specified when `type` is `%s`", containerservice.ResourceIdentityTypeUserAssigned)
+			}
+			identityIds = append(identityIds, userAssignedIdentityId)
 		}
+
+		model = append(model, identity.ModelSystemAssignedUserAssigned{
+			Type:        identityType,
+			IdentityIds: identityIds,
+		})
 	}
 
-	values := input[0].(map[string]interface{})
+	config, err := kubernetesClusterIdentity{}.ExpandModel(model)
+	if err != nil {
+		return nil, err
+	}
 
-	if containerservice.ResourceIdentityType(values["type"].(string)) == containerservice.ResourceIdentityTypeUserAssigned {
-		userAssignedIdentities := map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{
-			values["user_assigned_identity_id"].(string): {},
-		}
+	output := containerservice.ManagedClusterIdentity{
+		Type: containerservice.ResourceIdentityType(config.Type),
+	}
 
-		return &containerservice.ManagedClusterIdentity{
-			Type:                   containerservice.ResourceIdentityType(values["type"].(string)),
-			UserAssignedIdentities: userAssignedIdentities,
+	if config.UserAssignedIdentityIds != nil {
+		userAssignedIdentities := make(map[string]*containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue)
+		for _, id := range *config.UserAssignedIdentityIds {
+			userAssignedIdentities[id] = &containerservice.ManagedClusterIdentityUserAssignedIdentitiesValue{}
 		}
+		output.UserAssignedIdentities = userAssignedIdentities
 	}
 
-	return &containerservice.ManagedClusterIdentity{
-		Type: containerservice.ResourceIdentityType(values["type"].(string)),
-	}
+	return &output, nil
 }
 
 func flattenKubernetesClusterRoleBasedAccessControl(input *containerservice.ManagedClusterProperties, d *schema.ResourceData) []interface{} {
@@ -1941,41 +1971,39 @@ func flattenKubernetesClusterKubeConfigAAD(config kubernetes.KubeConfigAAD) []in
 }
 
 func flattenKubernetesClusterManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) ([]interface{}, error) {
-	// if it's none, omit the block
-	if input == nil || input.Type == containerservice.ResourceIdentityTypeNone {
-		return []interface{}{}, nil
-	}
-
-	identity := make(map[string]interface{})
-
-	identity["principal_id"] = ""
-	if input.PrincipalID != nil {
-		identity["principal_id"] = *input.PrincipalID
-	}
-
-	identity["tenant_id"] = ""
-	if input.TenantID != nil {
-		identity["tenant_id"] = *input.TenantID
-	}
-
-	identity["user_assigned_identity_id"] = ""
-	if input.UserAssignedIdentities != nil {
-		keys := []string{}
+	var config *identity.ExpandedConfig
+	if input != nil {
+		identityIds := make([]string, 0)
 		for key := range input.UserAssignedIdentities {
-			keys = append(keys, key)
+			identityIds = append(identityIds, key)
 		}
-		if len(keys) > 0 {
-			parsedId, err := msiparse.UserAssignedIdentityID(keys[0])
-			if err != nil {
-				return nil, err
-			}
-			identity["user_assigned_identity_id"] = parsedId.ID()
+
+		config = &identity.ExpandedConfig{
+			Type:                    string(input.Type),
+			PrincipalId:             input.PrincipalID,
+			TenantId:                input.TenantID,
+			UserAssignedIdentityIds: &identityIds,
 		}
 	}
 
-	identity["type"] = string(input.Type)
+	// if it's none, the block is omitted
+	models := kubernetesClusterIdentity{}.FlattenModel(config)
+
+	output := make([]interface{}, 0)
+	for _, v := range models {
+		userAssignedIdentityId := ""
+		if len(v.IdentityIds) > 0 {
+			userAssignedIdentityId = v.IdentityIds[0]
+		}
 
-	return []interface{}{identity}, nil
+		output = append(output, map[string]interface{}{
+			"type":                      v.Type,
+			"user_assigned_identity_id": userAssignedIdentityId,
+			"principal_id":              v.PrincipalId,
+			"tenant_id":                 v.TenantId,
+		})
+	}
+	return output, nil
 }
 
 func flattenKubernetesClusterAutoScalerProfile(profile *containerservice.ManagedClusterPropertiesAutoScalerProfile) []interface{} {
@@ -73,3 +73,55 @@ func NodePoolID(input string) (*NodePoolId, error) {
 
 	return &resourceId, nil
 }
+
+// NodePoolIDInsensitively parses an NodePool ID into an NodePoolId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NodePoolID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NodePoolIDInsensitively(input string) (*NodePoolId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NodePoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ManagedClusterName, err = id.PopSegmentInsensitively("managedClusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.AgentPoolName, err = id.PopSegmentInsensitively("agentPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeNodePoolID parses the specified NodePool ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeNodePoolID(input string) (string, error) {
+	id, err := NodePoolIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -79,3 +79,58 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 
 	return &resourceId, nil
 }
+
+// CassandraTableIDInsensitively parses an CassandraTable ID into an CassandraTableId struct, insensitively
+// This should only be used to parse an ID for rewriting, the CassandraTableID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func CassandraTableIDInsensitively(input string) (*CassandraTableId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CassandraTableId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.CassandraKeyspaceName, err = id.PopSegmentInsensitively("cassandraKeyspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TableName, err = id.PopSegmentInsensitively("tables"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeCassandraTableID parses the specified CassandraTable ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeCassandraTableID(input string) (string, error) {
+	id, err := CassandraTableIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
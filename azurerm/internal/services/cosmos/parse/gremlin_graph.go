@@ -79,3 +79,58 @@ func GremlinGraphID(input string) (*GremlinGraphId, error) {
 
 	return &resourceId, nil
 }
+
+// GremlinGraphIDInsensitively parses an GremlinGraph ID into an GremlinGraphId struct, insensitively
+// This should only be used to parse an ID for rewriting, the GremlinGraphID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func GremlinGraphIDInsensitively(input string) (*GremlinGraphId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := GremlinGraphId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.GremlinDatabaseName, err = id.PopSegmentInsensitively("gremlinDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.GraphName, err = id.PopSegmentInsensitively("graphs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeGremlinGraphID parses the specified GremlinGraph ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeGremlinGraphID(input string) (string, error) {
+	id, err := GremlinGraphIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
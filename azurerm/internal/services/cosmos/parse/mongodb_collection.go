@@ -79,3 +79,58 @@ func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 
 	return &resourceId, nil
 }
+
+// MongodbCollectionIDInsensitively parses an MongodbCollection ID into an MongodbCollectionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the MongodbCollectionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func MongodbCollectionIDInsensitively(input string) (*MongodbCollectionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MongodbCollectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.MongodbDatabaseName, err = id.PopSegmentInsensitively("mongodbDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.CollectionName, err = id.PopSegmentInsensitively("collections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeMongodbCollectionID parses the specified MongodbCollection ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeMongodbCollectionID(input string) (string, error) {
+	id, err := MongodbCollectionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -79,3 +79,58 @@ func SqlContainerID(input string) (*SqlContainerId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlContainerIDInsensitively parses an SqlContainer ID into an SqlContainerId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SqlContainerID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SqlContainerIDInsensitively(input string) (*SqlContainerId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SqlContainerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.SqlDatabaseName, err = id.PopSegmentInsensitively("sqlDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSqlContainerID parses the specified SqlContainer ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSqlContainerID(input string) (string, error) {
+	id, err := SqlContainerIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
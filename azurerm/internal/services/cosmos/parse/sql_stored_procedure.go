@@ -85,3 +85,61 @@ func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
 
 	return &resourceId, nil
 }
+
+// SqlStoredProcedureIDInsensitively parses an SqlStoredProcedure ID into an SqlStoredProcedureId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SqlStoredProcedureID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SqlStoredProcedureIDInsensitively(input string) (*SqlStoredProcedureId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SqlStoredProcedureId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.SqlDatabaseName, err = id.PopSegmentInsensitively("sqlDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
+		return nil, err
+	}
+	if resourceId.StoredProcedureName, err = id.PopSegmentInsensitively("storedProcedures"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSqlStoredProcedureID parses the specified SqlStoredProcedure ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSqlStoredProcedureID(input string) (string, error) {
+	id, err := SqlStoredProcedureIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
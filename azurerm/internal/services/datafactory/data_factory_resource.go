@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
@@ -207,12 +208,11 @@ func resourceDataFactoryCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		dataFactory.FactoryProperties.PublicNetworkAccess = datafactory.PublicNetworkAccessDisabled
 	}
 
-	if v, ok := d.GetOk("identity.0.type"); ok {
-		identityType := v.(string)
-		dataFactory.Identity = &datafactory.FactoryIdentity{
-			Type: &identityType,
-		}
+	factoryIdentity, err := expandDataFactoryIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
 	}
+	dataFactory.Identity = factoryIdentity
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, dataFactory, ""); err != nil {
 		return fmt.Errorf("Error creating/updating Data Factory %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -411,21 +411,38 @@ func flattenDataFactoryRepoConfiguration(factory *datafactory.Factory) (datafact
 	return datafactory.TypeFactoryRepoConfiguration, result
 }
 
-func flattenDataFactoryIdentity(identity *datafactory.FactoryIdentity) interface{} {
-	if identity == nil {
-		return make([]interface{}, 0)
-	}
+// dataFactoryIdentity is used to expand and flatten the `identity` block
+type dataFactoryIdentity = identity.SystemAssigned
 
-	result := make(map[string]interface{})
-	if identity.Type != nil {
-		result["type"] = *identity.Type
+func expandDataFactoryIdentity(input []interface{}) (*datafactory.FactoryIdentity, error) {
+	// the API doesn't support `None`, so the Identity is omitted when the block isn't specified
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
 	}
-	if identity.PrincipalID != nil {
-		result["principal_id"] = identity.PrincipalID.String()
-	}
-	if identity.TenantID != nil {
-		result["tenant_id"] = identity.TenantID.String()
+
+	config, err := dataFactoryIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
 	}
 
-	return []interface{}{result}
+	return &datafactory.FactoryIdentity{
+		Type: utils.String(config.Type),
+	}, nil
+}
+
+func flattenDataFactoryIdentity(input *datafactory.FactoryIdentity) []interface{} {
+	var config *identity.ExpandedConfig
+	if input != nil {
+		config = &identity.ExpandedConfig{}
+		if input.Type != nil {
+			config.Type = *input.Type
+		}
+		if input.PrincipalID != nil {
+			config.PrincipalId = utils.String(input.PrincipalID.String())
+		}
+		if input.TenantID != nil {
+			config.TenantId = utils.String(input.TenantID.String())
+		}
+	}
+	return dataFactoryIdentity{}.Flatten(config)
 }
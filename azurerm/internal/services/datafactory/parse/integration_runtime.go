@@ -73,3 +73,55 @@ func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
 
 	return &resourceId, nil
 }
+
+// IntegrationRuntimeIDInsensitively parses an IntegrationRuntime ID into an IntegrationRuntimeId struct, insensitively
+// This should only be used to parse an ID for rewriting, the IntegrationRuntimeID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func IntegrationRuntimeIDInsensitively(input string) (*IntegrationRuntimeId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := IntegrationRuntimeId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FactoryName, err = id.PopSegmentInsensitively("factories"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("integrationruntimes"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeIntegrationRuntimeID parses the specified IntegrationRuntime ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeIntegrationRuntimeID(input string) (string, error) {
+	id, err := IntegrationRuntimeIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
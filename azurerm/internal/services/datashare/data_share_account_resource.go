@@ -172,7 +172,7 @@ func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) er
 
 	props := datashare.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -75,7 +75,7 @@ func ApplicationGroupID(input string) (*ApplicationGroupId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func ApplicationGroupIDInsensitively(input string) (*ApplicationGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +93,7 @@ func ApplicationGroupIDInsensitively(input string) (*ApplicationGroupId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'applicationGroups' segment
-	applicationGroupsKey := "applicationGroups"
-	for key := range id.Path {
-		if strings.EqualFold(key, applicationGroupsKey) {
-			applicationGroupsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(applicationGroupsKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("applicationGroups"); err != nil {
 		return nil, err
 	}
 
@@ -111,3 +103,16 @@ func ApplicationGroupIDInsensitively(input string) (*ApplicationGroupId, error)
 
 	return &resourceId, nil
 }
+
+// NormalizeApplicationGroupID parses the specified ApplicationGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeApplicationGroupID(input string) (string, error) {
+	id, err := ApplicationGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
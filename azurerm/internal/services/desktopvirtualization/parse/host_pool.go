@@ -75,7 +75,7 @@ func HostPoolID(input string) (*HostPoolId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func HostPoolIDInsensitively(input string) (*HostPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +93,7 @@ func HostPoolIDInsensitively(input string) (*HostPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'hostPools' segment
-	hostPoolsKey := "hostPools"
-	for key := range id.Path {
-		if strings.EqualFold(key, hostPoolsKey) {
-			hostPoolsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(hostPoolsKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hostPools"); err != nil {
 		return nil, err
 	}
 
@@ -111,3 +103,16 @@ func HostPoolIDInsensitively(input string) (*HostPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeHostPoolID parses the specified HostPool ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeHostPoolID(input string) (string, error) {
+	id, err := HostPoolIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -145,7 +145,7 @@ func resourceDigitalTwinsInstanceUpdate(d *schema.ResourceData, meta interface{}
 
 	props := digitaltwins.PatchDescription{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -73,3 +73,55 @@ func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
 
 	return &resourceId, nil
 }
+
+// DigitalTwinsEndpointIDInsensitively parses an DigitalTwinsEndpoint ID into an DigitalTwinsEndpointId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DigitalTwinsEndpointID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DigitalTwinsEndpointIDInsensitively(input string) (*DigitalTwinsEndpointId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DigitalTwinsEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DigitalTwinsInstanceName, err = id.PopSegmentInsensitively("digitalTwinsInstances"); err != nil {
+		return nil, err
+	}
+	if resourceId.EndpointName, err = id.PopSegmentInsensitively("endpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDigitalTwinsEndpointID parses the specified DigitalTwinsEndpoint ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDigitalTwinsEndpointID(input string) (string, error) {
+	id, err := DigitalTwinsEndpointIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
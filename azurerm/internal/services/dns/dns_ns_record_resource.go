@@ -153,7 +153,7 @@ func resourceDnsNsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		existing.RecordSetProperties.NsRecords = records
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		existing.RecordSetProperties.Metadata = tags.Expand(t)
 	}
@@ -73,3 +73,55 @@ func ARecordID(input string) (*ARecordId, error) {
 
 	return &resourceId, nil
 }
+
+// ARecordIDInsensitively parses an ARecord ID into an ARecordId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ARecordID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ARecordIDInsensitively(input string) (*ARecordId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ARecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.AName, err = id.PopSegmentInsensitively("A"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeARecordID parses the specified ARecord ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeARecordID(input string) (string, error) {
+	id, err := ARecordIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func AaaaRecordID(input string) (*AaaaRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// AaaaRecordIDInsensitively parses an AaaaRecord ID into an AaaaRecordId struct, insensitively
+// This should only be used to parse an ID for rewriting, the AaaaRecordID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func AaaaRecordIDInsensitively(input string) (*AaaaRecordId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AaaaRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.AAAAName, err = id.PopSegmentInsensitively("AAAA"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeAaaaRecordID parses the specified AaaaRecord ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeAaaaRecordID(input string) (string, error) {
+	id, err := AaaaRecordIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func CaaRecordID(input string) (*CaaRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// CaaRecordIDInsensitively parses an CaaRecord ID into an CaaRecordId struct, insensitively
+// This should only be used to parse an ID for rewriting, the CaaRecordID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func CaaRecordIDInsensitively(input string) (*CaaRecordId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CaaRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.CAAName, err = id.PopSegmentInsensitively("CAA"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeCaaRecordID parses the specified CaaRecord ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeCaaRecordID(input string) (string, error) {
+	id, err := CaaRecordIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func CnameRecordID(input string) (*CnameRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// CnameRecordIDInsensitively parses an CnameRecord ID into an CnameRecordId struct, insensitively
+// This should only be used to parse an ID for rewriting, the CnameRecordID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func CnameRecordIDInsensitively(input string) (*CnameRecordId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := CnameRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.CNAMEName, err = id.PopSegmentInsensitively("CNAME"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeCnameRecordID parses the specified CnameRecord ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeCnameRecordID(input string) (string, error) {
+	id, err := CnameRecordIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func MxRecordID(input string) (*MxRecordId, error) {
 
 	return &resourceId, nil
 }
+
+// MxRecordIDInsensitively parses an MxRecord ID into an MxRecordId struct, insensitively
+// This should only be used to parse an ID for rewriting, the MxRecordID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func MxRecordIDInsensitively(input string) (*MxRecordId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := MxRecordId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
+		return nil, err
+	}
+	if resourceId.MXName, err = id.PopSegmentInsensitively("MX"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeMxRecordID parses the specified MxRecord ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeMxRecordID(input string) (string, error) {
+	id, err := MxRecordIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
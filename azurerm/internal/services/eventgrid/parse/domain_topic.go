@@ -73,3 +73,55 @@ func DomainTopicID(input string) (*DomainTopicId, error) {
 
 	return &resourceId, nil
 }
+
+// DomainTopicIDInsensitively parses an DomainTopic ID into an DomainTopicId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DomainTopicID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DomainTopicIDInsensitively(input string) (*DomainTopicId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DomainTopicId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DomainName, err = id.PopSegmentInsensitively("domains"); err != nil {
+		return nil, err
+	}
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDomainTopicID parses the specified DomainTopic ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDomainTopicID(input string) (string, error) {
+	id, err := DomainTopicIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
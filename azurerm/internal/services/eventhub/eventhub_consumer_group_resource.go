@@ -25,6 +25,7 @@ type ConsumerGroupObject struct {
 
 var _ sdk.Resource = ConsumerGroupResource{}
 var _ sdk.ResourceWithUpdate = ConsumerGroupResource{}
+var _ sdk.ResourceWithIDNormalization = ConsumerGroupResource{}
 
 type ConsumerGroupResource struct {
 }
@@ -205,3 +206,7 @@ func (r ConsumerGroupResource) ModelObject() interface{} {
 func (r ConsumerGroupResource) IDValidationFunc() schema.SchemaValidateFunc {
 	return validate.EventHubConsumerGroupID
 }
+
+func (r ConsumerGroupResource) IDNormalizationFunc() sdk.IDNormalizationFunc {
+	return parse.NormalizeEventHubConsumerGroupID
+}
@@ -3,6 +3,7 @@ package eventhub_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -50,6 +51,35 @@ func TestAccEventHubConsumerGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccEventHubConsumerGroup_importInsensitively(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventhub_consumer_group", "test")
+	r := EventHubConsumerGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// Azure can return this ID using a different casing, which is normalized during import
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(state *terraform.State) (string, error) {
+				rs, ok := state.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+
+				id := strings.Replace(rs.Primary.ID, "/resourceGroups/", "/resourcegroups/", 1)
+				return strings.Replace(id, "/consumergroups/", "/consumerGroups/", 1), nil
+			},
+		},
+	})
+}
+
 func TestAccEventHubConsumerGroup_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_eventhub_consumer_group", "test")
 	r := EventHubConsumerGroupResource{}
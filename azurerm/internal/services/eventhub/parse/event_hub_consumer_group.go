@@ -79,3 +79,58 @@ func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// EventHubConsumerGroupIDInsensitively parses an EventHubConsumerGroup ID into an EventHubConsumerGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the EventHubConsumerGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func EventHubConsumerGroupIDInsensitively(input string) (*EventHubConsumerGroupId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := EventHubConsumerGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.EventhubName, err = id.PopSegmentInsensitively("eventhubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.ConsumergroupName, err = id.PopSegmentInsensitively("consumergroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeEventHubConsumerGroupID parses the specified EventHubConsumerGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeEventHubConsumerGroupID(input string) (string, error) {
+	id, err := EventHubConsumerGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
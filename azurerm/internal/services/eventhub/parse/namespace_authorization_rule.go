@@ -81,7 +81,7 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -99,27 +99,10 @@ func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthoriz
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'namespaces' segment
-	namespacesKey := "namespaces"
-	for key := range id.Path {
-		if strings.EqualFold(key, namespacesKey) {
-			namespacesKey = key
-			break
-		}
-	}
-	if resourceId.NamespaceName, err = id.PopSegment(namespacesKey); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-
-	// find the correct casing for the 'authorizationRules' segment
-	authorizationRulesKey := "authorizationRules"
-	for key := range id.Path {
-		if strings.EqualFold(key, authorizationRulesKey) {
-			authorizationRulesKey = key
-			break
-		}
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment(authorizationRulesKey); err != nil {
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("authorizationRules"); err != nil {
 		return nil, err
 	}
 
@@ -129,3 +112,16 @@ func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthoriz
 
 	return &resourceId, nil
 }
+
+// NormalizeNamespaceAuthorizationRuleID parses the specified NamespaceAuthorizationRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeNamespaceAuthorizationRuleID(input string) (string, error) {
+	id, err := NamespaceAuthorizationRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
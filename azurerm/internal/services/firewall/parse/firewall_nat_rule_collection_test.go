@@ -3,7 +3,10 @@ package parse
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
@@ -126,3 +129,266 @@ func TestFirewallNatRuleCollectionID(t *testing.T) {
 		}
 	}
 }
+
+func TestFirewallNatRuleCollectionIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *FirewallNatRuleCollectionId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing AzureFirewallName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/",
+			Error: true,
+		},
+
+		{
+			// missing value for AzureFirewallName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/",
+			Error: true,
+		},
+
+		{
+			// missing NatRuleCollectionName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/",
+			Error: true,
+		},
+
+		{
+			// missing value for NatRuleCollectionName
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// lower-cased segment names
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/mygroup1/providers/Microsoft.Network/azurefirewalls/myfirewall/natrulecollections/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/Microsoft.Network/AZUREFIREWALLS/myfirewall/NATRULECOLLECTIONS/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+
+		{
+			// mixed-cased segment names
+			Input: "/SuBsCrIpTiOnS/00000000-0000-0000-0000-000000000000/ReSoUrCeGrOuPs/mygroup1/PrOvIdErS/Microsoft.Network/AzUrEfIrEwAlLs/myfirewall/NaTrUlEcOlLeCtIoNs/natRuleCollection1",
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "mygroup1",
+				AzureFirewallName:     "myfirewall",
+				NatRuleCollectionName: "natRuleCollection1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := FirewallNatRuleCollectionIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.AzureFirewallName != v.Expected.AzureFirewallName {
+			t.Fatalf("Expected %q but got %q for AzureFirewallName", v.Expected.AzureFirewallName, actual.AzureFirewallName)
+		}
+		if actual.NatRuleCollectionName != v.Expected.NatRuleCollectionName {
+			t.Fatalf("Expected %q but got %q for NatRuleCollectionName", v.Expected.NatRuleCollectionName, actual.NatRuleCollectionName)
+		}
+	}
+}
+
+func TestNormalizeFirewallNatRuleCollectionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+		},
+
+		{
+			// lower-cased segment names
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/mygroup1/providers/Microsoft.Network/azurefirewalls/myfirewall/natrulecollections/natRuleCollection1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+		},
+
+		{
+			// upper-cased segment names
+			Input:    "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/Microsoft.Network/AZUREFIREWALLS/myfirewall/NATRULECOLLECTIONS/natRuleCollection1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+		},
+
+		{
+			// lower-cased segment names and resource provider
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/mygroup1/providers/microsoft.network/azurefirewalls/myfirewall/natrulecollections/natRuleCollection1",
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := NormalizeFirewallNatRuleCollectionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestFirewallNatRuleCollectionIDRoundTrip(t *testing.T) {
+	expected := NewFirewallNatRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "natRuleCollection1")
+
+	actual, err := FirewallNatRuleCollectionID(expected.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if *actual != expected {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+
+	normalized, err := NormalizeFirewallNatRuleCollectionID(actual.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %s", err)
+	}
+	if normalized != expected.ID() {
+		t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+	}
+}
+
+func TestFirewallNatRuleCollectionIDInsensitivelyFuzz(t *testing.T) {
+	expected := NewFirewallNatRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "natRuleCollection1")
+
+	// a fixed seed is used so that any failures are reproducible
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		// the casing of each of the segment names (and the resource provider) is randomised, the values
+		// are case-sensitive so are left as-is
+		components := strings.Split(expected.ID(), "/")
+		for j := 1; j < len(components); j += 2 {
+			indexes := []int{j}
+			if components[j] == "providers" && j+1 < len(components) {
+				indexes = append(indexes, j+1)
+			}
+
+			for _, index := range indexes {
+				chars := []rune(components[index])
+				for k := range chars {
+					if random.Intn(2) == 0 {
+						chars[k] = unicode.ToUpper(chars[k])
+					} else {
+						chars[k] = unicode.ToLower(chars[k])
+					}
+				}
+				components[index] = string(chars)
+			}
+		}
+		input := strings.Join(components, "/")
+		t.Logf("[DEBUG] Testing %q", input)
+
+		actual, err := FirewallNatRuleCollectionIDInsensitively(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if *actual != expected {
+			t.Fatalf("Expected %+v but got %+v", expected, *actual)
+		}
+
+		normalized, err := NormalizeFirewallNatRuleCollectionID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if normalized != expected.ID() {
+			t.Fatalf("Expected %q but got %q", expected.ID(), normalized)
+		}
+	}
+}
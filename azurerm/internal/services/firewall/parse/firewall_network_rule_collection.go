@@ -73,3 +73,55 @@ func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollecti
 
 	return &resourceId, nil
 }
+
+// FirewallNetworkRuleCollectionIDInsensitively parses an FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the FirewallNetworkRuleCollectionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func FirewallNetworkRuleCollectionIDInsensitively(input string) (*FirewallNetworkRuleCollectionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FirewallNetworkRuleCollectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegmentInsensitively("azureFirewalls"); err != nil {
+		return nil, err
+	}
+	if resourceId.NetworkRuleCollectionName, err = id.PopSegmentInsensitively("networkRuleCollections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeFirewallNetworkRuleCollectionID parses the specified FirewallNetworkRuleCollection ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeFirewallNetworkRuleCollectionID(input string) (string, error) {
+	id, err := FirewallNetworkRuleCollectionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleColle
 
 	return &resourceId, nil
 }
+
+// FirewallPolicyRuleCollectionGroupIDInsensitively parses an FirewallPolicyRuleCollectionGroup ID into an FirewallPolicyRuleCollectionGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the FirewallPolicyRuleCollectionGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func FirewallPolicyRuleCollectionGroupIDInsensitively(input string) (*FirewallPolicyRuleCollectionGroupId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := FirewallPolicyRuleCollectionGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.FirewallPolicyName, err = id.PopSegmentInsensitively("firewallPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleCollectionGroupName, err = id.PopSegmentInsensitively("ruleCollectionGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeFirewallPolicyRuleCollectionGroupID parses the specified FirewallPolicyRuleCollectionGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeFirewallPolicyRuleCollectionGroupID(input string) (string, error) {
+	id, err := FirewallPolicyRuleCollectionGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -81,7 +81,7 @@ func BackendPoolID(input string) (*BackendPoolId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -99,27 +99,10 @@ func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-
-	// find the correct casing for the 'backendPools' segment
-	backendPoolsKey := "backendPools"
-	for key := range id.Path {
-		if strings.EqualFold(key, backendPoolsKey) {
-			backendPoolsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(backendPoolsKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("backendPools"); err != nil {
 		return nil, err
 	}
 
@@ -129,3 +112,16 @@ func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeBackendPoolID parses the specified BackendPool ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeBackendPoolID(input string) (string, error) {
+	id, err := BackendPoolIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
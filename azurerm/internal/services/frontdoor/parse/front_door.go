@@ -75,7 +75,7 @@ func FrontDoorID(input string) (*FrontDoorId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func FrontDoorIDInsensitively(input string) (*FrontDoorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +93,7 @@ func FrontDoorIDInsensitively(input string) (*FrontDoorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(frontDoorsKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
 
@@ -111,3 +103,16 @@ func FrontDoorIDInsensitively(input string) (*FrontDoorId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeFrontDoorID parses the specified FrontDoor ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeFrontDoorID(input string) (string, error) {
+	id, err := FrontDoorIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
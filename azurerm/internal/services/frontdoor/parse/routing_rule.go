@@ -81,7 +81,7 @@ func RoutingRuleID(input string) (*RoutingRuleId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func RoutingRuleIDInsensitively(input string) (*RoutingRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -99,27 +99,10 @@ func RoutingRuleIDInsensitively(input string) (*RoutingRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-
-	// find the correct casing for the 'routingRules' segment
-	routingRulesKey := "routingRules"
-	for key := range id.Path {
-		if strings.EqualFold(key, routingRulesKey) {
-			routingRulesKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(routingRulesKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("routingRules"); err != nil {
 		return nil, err
 	}
 
@@ -129,3 +112,16 @@ func RoutingRuleIDInsensitively(input string) (*RoutingRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeRoutingRuleID parses the specified RoutingRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeRoutingRuleID(input string) (string, error) {
+	id, err := RoutingRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
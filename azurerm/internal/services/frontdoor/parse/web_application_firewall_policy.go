@@ -75,7 +75,7 @@ func WebApplicationFirewallPolicyID(input string) (*WebApplicationFirewallPolicy
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func WebApplicationFirewallPolicyIDInsensitively(input string) (*WebApplicationFirewallPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +93,7 @@ func WebApplicationFirewallPolicyIDInsensitively(input string) (*WebApplicationF
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'frontDoorWebApplicationFirewallPolicies' segment
-	frontDoorWebApplicationFirewallPoliciesKey := "frontDoorWebApplicationFirewallPolicies"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorWebApplicationFirewallPoliciesKey) {
-			frontDoorWebApplicationFirewallPoliciesKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorWebApplicationFirewallPolicyName, err = id.PopSegment(frontDoorWebApplicationFirewallPoliciesKey); err != nil {
+	if resourceId.FrontDoorWebApplicationFirewallPolicyName, err = id.PopSegmentInsensitively("frontDoorWebApplicationFirewallPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -111,3 +103,16 @@ func WebApplicationFirewallPolicyIDInsensitively(input string) (*WebApplicationF
 
 	return &resourceId, nil
 }
+
+// NormalizeWebApplicationFirewallPolicyID parses the specified WebApplicationFirewallPolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeWebApplicationFirewallPolicyID(input string) (string, error) {
+	id, err := WebApplicationFirewallPolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
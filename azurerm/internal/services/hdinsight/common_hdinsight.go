@@ -32,7 +32,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 		resourceGroup := id.ResourceGroup
 		name := id.Name
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
 				Tags: tags.Expand(t),
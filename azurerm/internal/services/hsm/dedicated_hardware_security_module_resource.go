@@ -218,7 +218,7 @@ func resourceDedicatedHardwareSecurityModuleUpdate(d *schema.ResourceData, meta
 	}
 
 	parameters := hardwaresecuritymodules.DedicatedHsmPatchParameters{}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -67,3 +67,52 @@ func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurity
 
 	return &resourceId, nil
 }
+
+// DedicatedHardwareSecurityModuleIDInsensitively parses an DedicatedHardwareSecurityModule ID into an DedicatedHardwareSecurityModuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DedicatedHardwareSecurityModuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DedicatedHardwareSecurityModuleIDInsensitively(input string) (*DedicatedHardwareSecurityModuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DedicatedHardwareSecurityModuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DedicatedHSMName, err = id.PopSegmentInsensitively("dedicatedHSMs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDedicatedHardwareSecurityModuleID parses the specified DedicatedHardwareSecurityModule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDedicatedHardwareSecurityModuleID(input string) (string, error) {
+	id, err := DedicatedHardwareSecurityModuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
 
 	return &resourceId, nil
 }
+
+// ReferenceDataSetIDInsensitively parses an ReferenceDataSet ID into an ReferenceDataSetId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ReferenceDataSetID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ReferenceDataSetIDInsensitively(input string) (*ReferenceDataSetId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ReferenceDataSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.EnvironmentName, err = id.PopSegmentInsensitively("environments"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("referenceDataSets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeReferenceDataSetID parses the specified ReferenceDataSet ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeReferenceDataSetID(input string) (string, error) {
+	id, err := ReferenceDataSetIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -566,7 +566,7 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 		update.Properties.TenantID = &tenantUUID
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		update.Tags = tags.Expand(t)
 	}
@@ -73,3 +73,55 @@ func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurati
 
 	return &resourceId, nil
 }
+
+// AttachedDatabaseConfigurationIDInsensitively parses an AttachedDatabaseConfiguration ID into an AttachedDatabaseConfigurationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the AttachedDatabaseConfigurationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func AttachedDatabaseConfigurationIDInsensitively(input string) (*AttachedDatabaseConfigurationId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AttachedDatabaseConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("AttachedDatabaseConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeAttachedDatabaseConfigurationID parses the specified AttachedDatabaseConfiguration ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeAttachedDatabaseConfigurationID(input string) (string, error) {
+	id, err := AttachedDatabaseConfigurationIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
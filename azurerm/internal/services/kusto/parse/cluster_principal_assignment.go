@@ -73,3 +73,55 @@ func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId,
 
 	return &resourceId, nil
 }
+
+// ClusterPrincipalAssignmentIDInsensitively parses an ClusterPrincipalAssignment ID into an ClusterPrincipalAssignmentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ClusterPrincipalAssignmentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ClusterPrincipalAssignmentIDInsensitively(input string) (*ClusterPrincipalAssignmentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ClusterPrincipalAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrincipalAssignmentName, err = id.PopSegmentInsensitively("PrincipalAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeClusterPrincipalAssignmentID parses the specified ClusterPrincipalAssignment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeClusterPrincipalAssignmentID(input string) (string, error) {
+	id, err := ClusterPrincipalAssignmentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
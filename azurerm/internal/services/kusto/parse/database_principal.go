@@ -85,3 +85,61 @@ func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 
 	return &resourceId, nil
 }
+
+// DatabasePrincipalIDInsensitively parses an DatabasePrincipal ID into an DatabasePrincipalId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DatabasePrincipalID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DatabasePrincipalIDInsensitively(input string) (*DatabasePrincipalId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabasePrincipalId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("Databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.RoleName, err = id.PopSegmentInsensitively("Role"); err != nil {
+		return nil, err
+	}
+	if resourceId.FQNName, err = id.PopSegmentInsensitively("FQN"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDatabasePrincipalID parses the specified DatabasePrincipal ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDatabasePrincipalID(input string) (string, error) {
+	id, err := DatabasePrincipalIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -79,3 +79,58 @@ func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId
 
 	return &resourceId, nil
 }
+
+// DatabasePrincipalAssignmentIDInsensitively parses an DatabasePrincipalAssignment ID into an DatabasePrincipalAssignmentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DatabasePrincipalAssignmentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DatabasePrincipalAssignmentIDInsensitively(input string) (*DatabasePrincipalAssignmentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabasePrincipalAssignmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("Databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrincipalAssignmentName, err = id.PopSegmentInsensitively("PrincipalAssignments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDatabasePrincipalAssignmentID parses the specified DatabasePrincipalAssignment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDatabasePrincipalAssignmentID(input string) (string, error) {
+	id, err := DatabasePrincipalAssignmentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
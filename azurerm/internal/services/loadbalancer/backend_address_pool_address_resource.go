@@ -18,6 +18,7 @@ import (
 
 var _ sdk.Resource = BackendAddressPoolAddressResource{}
 var _ sdk.ResourceWithUpdate = BackendAddressPoolAddressResource{}
+var _ sdk.ResourceWithIDNormalization = BackendAddressPoolAddressResource{}
 
 type BackendAddressPoolAddressResource struct{}
 
@@ -266,6 +267,10 @@ func (r BackendAddressPoolAddressResource) IDValidationFunc() schema.SchemaValid
 	return validate.BackendAddressPoolAddressID
 }
 
+func (r BackendAddressPoolAddressResource) IDNormalizationFunc() sdk.IDNormalizationFunc {
+	return parse.NormalizeBackendAddressPoolAddressID
+}
+
 func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
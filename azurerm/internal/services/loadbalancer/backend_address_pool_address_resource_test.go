@@ -3,6 +3,7 @@ package loadbalancer_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
@@ -48,6 +49,35 @@ func TestAccBackendAddressPoolAddressRequiresImport(t *testing.T) {
 	})
 }
 
+func TestAccBackendAddressPoolAddressImportInsensitively(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test")
+	r := BackendAddressPoolAddressResourceTests{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// Azure can return this ID using a different casing, which is normalized during import
+			ResourceName:      data.ResourceName,
+			ImportState:       true,
+			ImportStateVerify: true,
+			ImportStateIdFunc: func(state *terraform.State) (string, error) {
+				rs, ok := state.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+
+				id := strings.Replace(rs.Primary.ID, "/resourceGroups/", "/resourcegroups/", 1)
+				return strings.Replace(id, "/backendAddressPools/", "/backendaddresspools/", 1), nil
+			},
+		},
+	})
+}
+
 func TestAccBackendAddressPoolAddressDisappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_lb_backend_address_pool_address", "test")
 	r := BackendAddressPoolAddressResourceTests{}
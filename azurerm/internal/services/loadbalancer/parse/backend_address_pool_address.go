@@ -79,3 +79,58 @@ func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, er
 
 	return &resourceId, nil
 }
+
+// BackendAddressPoolAddressIDInsensitively parses an BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct, insensitively
+// This should only be used to parse an ID for rewriting, the BackendAddressPoolAddressID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func BackendAddressPoolAddressIDInsensitively(input string) (*BackendAddressPoolAddressId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := BackendAddressPoolAddressId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
+		return nil, err
+	}
+	if resourceId.BackendAddressPoolName, err = id.PopSegmentInsensitively("backendAddressPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.AddressName, err = id.PopSegmentInsensitively("addresses"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeBackendAddressPoolAddressID parses the specified BackendAddressPoolAddress ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeBackendAddressPoolAddressID(input string) (string, error) {
+	id, err := BackendAddressPoolAddressIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
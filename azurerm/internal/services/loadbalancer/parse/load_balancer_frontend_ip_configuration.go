@@ -73,3 +73,55 @@ func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendI
 
 	return &resourceId, nil
 }
+
+// LoadBalancerFrontendIpConfigurationIDInsensitively parses an LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LoadBalancerFrontendIpConfigurationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LoadBalancerFrontendIpConfigurationIDInsensitively(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LoadBalancerFrontendIpConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
+		return nil, err
+	}
+	if resourceId.FrontendIPConfigurationName, err = id.PopSegmentInsensitively("frontendIPConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLoadBalancerFrontendIpConfigurationID parses the specified LoadBalancerFrontendIpConfiguration ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLoadBalancerFrontendIpConfigurationID(input string) (string, error) {
+	id, err := LoadBalancerFrontendIpConfigurationIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
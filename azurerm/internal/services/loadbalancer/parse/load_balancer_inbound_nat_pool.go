@@ -73,3 +73,55 @@ func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatPoolIDInsensitively parses an LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LoadBalancerInboundNatPoolID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LoadBalancerInboundNatPoolIDInsensitively(input string) (*LoadBalancerInboundNatPoolId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LoadBalancerInboundNatPoolId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
+		return nil, err
+	}
+	if resourceId.InboundNatPoolName, err = id.PopSegmentInsensitively("inboundNatPools"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLoadBalancerInboundNatPoolID parses the specified LoadBalancerInboundNatPool ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLoadBalancerInboundNatPoolID(input string) (string, error) {
+	id, err := LoadBalancerInboundNatPoolIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId,
 
 	return &resourceId, nil
 }
+
+// LoadBalancerInboundNatRuleIDInsensitively parses an LoadBalancerInboundNatRule ID into an LoadBalancerInboundNatRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LoadBalancerInboundNatRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LoadBalancerInboundNatRuleIDInsensitively(input string) (*LoadBalancerInboundNatRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LoadBalancerInboundNatRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
+		return nil, err
+	}
+	if resourceId.InboundNatRuleName, err = id.PopSegmentInsensitively("inboundNatRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLoadBalancerInboundNatRuleID parses the specified LoadBalancerInboundNatRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLoadBalancerInboundNatRuleID(input string) (string, error) {
+	id, err := LoadBalancerInboundNatRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, erro
 
 	return &resourceId, nil
 }
+
+// LoadBalancerOutboundRuleIDInsensitively parses an LoadBalancerOutboundRule ID into an LoadBalancerOutboundRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LoadBalancerOutboundRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LoadBalancerOutboundRuleIDInsensitively(input string) (*LoadBalancerOutboundRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LoadBalancerOutboundRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
+		return nil, err
+	}
+	if resourceId.OutboundRuleName, err = id.PopSegmentInsensitively("outboundRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLoadBalancerOutboundRuleID parses the specified LoadBalancerOutboundRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLoadBalancerOutboundRuleID(input string) (string, error) {
+	id, err := LoadBalancerOutboundRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -216,7 +216,7 @@ func resourceLogAnalyticsClusterUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
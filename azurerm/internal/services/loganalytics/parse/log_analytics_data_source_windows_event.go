@@ -73,3 +73,55 @@ func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSource
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsDataSourceWindowsEventIDInsensitively parses an LogAnalyticsDataSourceWindowsEvent ID into an LogAnalyticsDataSourceWindowsEventId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LogAnalyticsDataSourceWindowsEventID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LogAnalyticsDataSourceWindowsEventIDInsensitively(input string) (*LogAnalyticsDataSourceWindowsEventId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogAnalyticsDataSourceWindowsEventId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.DataSourceName, err = id.PopSegmentInsensitively("dataSources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLogAnalyticsDataSourceWindowsEventID parses the specified LogAnalyticsDataSourceWindowsEvent ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLogAnalyticsDataSourceWindowsEventID(input string) (string, error) {
+	id, err := LogAnalyticsDataSourceWindowsEventIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, er
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsLinkedServiceIDInsensitively parses an LogAnalyticsLinkedService ID into an LogAnalyticsLinkedServiceId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LogAnalyticsLinkedServiceID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LogAnalyticsLinkedServiceIDInsensitively(input string) (*LogAnalyticsLinkedServiceId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogAnalyticsLinkedServiceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.LinkedServiceName, err = id.PopSegmentInsensitively("linkedServices"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLogAnalyticsLinkedServiceID parses the specified LogAnalyticsLinkedService ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLogAnalyticsLinkedServiceID(input string) (string, error) {
+	id, err := LogAnalyticsLinkedServiceIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
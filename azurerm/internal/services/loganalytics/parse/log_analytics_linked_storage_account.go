@@ -73,3 +73,55 @@ func LogAnalyticsLinkedStorageAccountID(input string) (*LogAnalyticsLinkedStorag
 
 	return &resourceId, nil
 }
+
+// LogAnalyticsLinkedStorageAccountIDInsensitively parses an LogAnalyticsLinkedStorageAccount ID into an LogAnalyticsLinkedStorageAccountId struct, insensitively
+// This should only be used to parse an ID for rewriting, the LogAnalyticsLinkedStorageAccountID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func LogAnalyticsLinkedStorageAccountIDInsensitively(input string) (*LogAnalyticsLinkedStorageAccountId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LogAnalyticsLinkedStorageAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.LinkedStorageAccountName, err = id.PopSegmentInsensitively("linkedStorageAccounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeLogAnalyticsLinkedStorageAccountID parses the specified LogAnalyticsLinkedStorageAccount ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeLogAnalyticsLinkedStorageAccountID(input string) (string, error) {
+	id, err := LogAnalyticsLinkedStorageAccountIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -316,7 +316,7 @@ func resourceMachineLearningWorkspaceUpdate(d *schema.ResourceData, meta interfa
 		update.WorkspacePropertiesUpdateParameters.FriendlyName = utils.String(d.Get("friendly_name").(string))
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
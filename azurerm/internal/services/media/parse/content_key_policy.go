@@ -73,3 +73,55 @@ func ContentKeyPolicyID(input string) (*ContentKeyPolicyId, error) {
 
 	return &resourceId, nil
 }
+
+// ContentKeyPolicyIDInsensitively parses an ContentKeyPolicy ID into an ContentKeyPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ContentKeyPolicyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ContentKeyPolicyIDInsensitively(input string) (*ContentKeyPolicyId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ContentKeyPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("contentkeypolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeContentKeyPolicyID parses the specified ContentKeyPolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeContentKeyPolicyID(input string) (string, error) {
+	id, err := ContentKeyPolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
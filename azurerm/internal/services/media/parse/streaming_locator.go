@@ -73,3 +73,55 @@ func StreamingLocatorID(input string) (*StreamingLocatorId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamingLocatorIDInsensitively parses an StreamingLocator ID into an StreamingLocatorId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StreamingLocatorID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StreamingLocatorIDInsensitively(input string) (*StreamingLocatorId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StreamingLocatorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("streaminglocators"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStreamingLocatorID parses the specified StreamingLocator ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStreamingLocatorID(input string) (string, error) {
+	id, err := StreamingLocatorIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
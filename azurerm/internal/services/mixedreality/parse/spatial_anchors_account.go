@@ -67,3 +67,52 @@ func SpatialAnchorsAccountID(input string) (*SpatialAnchorsAccountId, error) {
 
 	return &resourceId, nil
 }
+
+// SpatialAnchorsAccountIDInsensitively parses an SpatialAnchorsAccount ID into an SpatialAnchorsAccountId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SpatialAnchorsAccountID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SpatialAnchorsAccountIDInsensitively(input string) (*SpatialAnchorsAccountId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SpatialAnchorsAccountId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegmentInsensitively("spatialAnchorsAccounts"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSpatialAnchorsAccountID parses the specified SpatialAnchorsAccount ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSpatialAnchorsAccountID(input string) (string, error) {
+	id, err := SpatialAnchorsAccountIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -0,0 +1,515 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// a Database only has a single Data Masking Policy, which is always named `Default`
+const msSqlDatabaseDataMaskingPolicyName = "Default"
+
+func resourceMsSqlDatabaseDataMaskingPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlDatabaseDataMaskingPolicyCreateUpdate,
+		Read:   resourceMsSqlDatabaseDataMaskingPolicyRead,
+		Update: resourceMsSqlDatabaseDataMaskingPolicyCreateUpdate,
+		Delete: resourceMsSqlDatabaseDataMaskingPolicyDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DatabaseDataMaskingPolicyID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// the Database Users which receive the results of queries without masking
+			"exempted_principals": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schema_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+
+						"column_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+
+						"masking_function": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.DataMaskingFunctionCCN),
+								string(sql.DataMaskingFunctionDefault),
+								string(sql.DataMaskingFunctionEmail),
+								string(sql.DataMaskingFunctionNumber),
+								string(sql.DataMaskingFunctionSSN),
+								string(sql.DataMaskingFunctionText),
+							}, false),
+						},
+
+						// only used when `masking_function` is `Number`
+						"number_from": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						"number_to": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+
+						// only used when `masking_function` is `Text`
+						"prefix_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"suffix_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+
+						"replacement_string": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlDatabaseDataMaskingPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DataMaskingPoliciesClient
+	rulesClient := meta.(*clients.Client).MSSQL.DataMaskingRulesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDatabaseDataMaskingPolicyID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, msSqlDatabaseDataMaskingPolicyName)
+	if d.IsNewResource() {
+		// the Data Masking Policy always exists, so we can only check whether it's been enabled
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if props := existing.DataMaskingPolicyProperties; props != nil && props.DataMaskingState == sql.DataMaskingStateEnabled {
+			return tf.ImportAsExistsError("azurerm_mssql_database_data_masking_policy", id.ID())
+		}
+	}
+
+	state := sql.DataMaskingStateDisabled
+	if d.Get("enabled").(bool) {
+		state = sql.DataMaskingStateEnabled
+	}
+
+	parameters := sql.DataMaskingPolicy{
+		DataMaskingPolicyProperties: &sql.DataMaskingPolicyProperties{
+			DataMaskingState: state,
+			ExemptPrincipals: utils.String(expandMsSqlDatabaseDataMaskingPolicyExemptedPrincipals(d.Get("exempted_principals").(*schema.Set).List())),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	rules, err := expandMsSqlDatabaseDataMaskingPolicyRules(d.Get("rule").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+
+	existingRules, err := listMsSqlDatabaseDataMaskingPolicyEnabledRules(ctx, rulesClient, id)
+	if err != nil {
+		return err
+	}
+
+	// the API has no Delete operation for Rules, instead they're removed by disabling them
+	removedRules := make([]sql.DataMaskingRule, 0)
+	existingRuleNames := make(map[string]string)
+	for key, rule := range existingRules {
+		if _, ok := rules[key]; !ok {
+			removedRules = append(removedRules, rule)
+			continue
+		}
+
+		if rule.Name != nil {
+			existingRuleNames[key] = *rule.Name
+		}
+	}
+
+	if err := disableMsSqlDatabaseDataMaskingPolicyRules(ctx, rulesClient, id, removedRules); err != nil {
+		return err
+	}
+
+	for key, rule := range rules {
+		// a Column can only have a single Rule, so any existing Rule for the Column is updated in-place
+		name, ok := existingRuleNames[key]
+		if !ok {
+			name = msSqlDatabaseDataMaskingRuleName(*rule.SchemaName, *rule.TableName, *rule.ColumnName)
+		}
+
+		if _, err := rulesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, name, rule); err != nil {
+			return fmt.Errorf("creating/updating Rule %q for %s: %+v", name, id, err)
+		}
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlDatabaseDataMaskingPolicyRead(d, meta)
+}
+
+func resourceMsSqlDatabaseDataMaskingPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DataMaskingPoliciesClient
+	rulesClient := meta.(*clients.Client).MSSQL.DataMaskingRulesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseDataMaskingPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("database_id", parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName).ID())
+
+	if props := resp.DataMaskingPolicyProperties; props != nil {
+		d.Set("enabled", props.DataMaskingState == sql.DataMaskingStateEnabled)
+
+		if err := d.Set("exempted_principals", flattenMsSqlDatabaseDataMaskingPolicyExemptedPrincipals(props.ExemptPrincipals)); err != nil {
+			return fmt.Errorf("setting `exempted_principals`: %+v", err)
+		}
+	}
+
+	existingRules, err := listMsSqlDatabaseDataMaskingPolicyEnabledRules(ctx, rulesClient, *id)
+	if err != nil {
+		return err
+	}
+
+	rules, err := flattenMsSqlDatabaseDataMaskingPolicyRules(existingRules)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return fmt.Errorf("setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlDatabaseDataMaskingPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DataMaskingPoliciesClient
+	rulesClient := meta.(*clients.Client).MSSQL.DataMaskingRulesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseDataMaskingPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	existingRules, err := listMsSqlDatabaseDataMaskingPolicyEnabledRules(ctx, rulesClient, *id)
+	if err != nil {
+		return err
+	}
+
+	rules := make([]sql.DataMaskingRule, 0)
+	for _, rule := range existingRules {
+		rules = append(rules, rule)
+	}
+
+	if err := disableMsSqlDatabaseDataMaskingPolicyRules(ctx, rulesClient, *id, rules); err != nil {
+		return err
+	}
+
+	// the Data Masking Policy can't be deleted, so instead we disable it
+	parameters := sql.DataMaskingPolicy{
+		DataMaskingPolicyProperties: &sql.DataMaskingPolicyProperties{
+			DataMaskingState: sql.DataMaskingStateDisabled,
+			ExemptPrincipals: utils.String(""),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, parameters); err != nil {
+		return fmt.Errorf("disabling %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// listMsSqlDatabaseDataMaskingPolicyEnabledRules returns the enabled Rules within the Data Masking Policy,
+// keyed by the Column they apply to - since disabled Rules are still returned from the API
+func listMsSqlDatabaseDataMaskingPolicyEnabledRules(ctx context.Context, client *sql.DataMaskingRulesClient, id parse.DatabaseDataMaskingPolicyId) (map[string]sql.DataMaskingRule, error) {
+	resp, err := client.ListByDatabase(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		return nil, fmt.Errorf("listing Rules for %s: %+v", id, err)
+	}
+
+	rules := make(map[string]sql.DataMaskingRule)
+	if resp.Value == nil {
+		return rules, nil
+	}
+
+	for _, rule := range *resp.Value {
+		props := rule.DataMaskingRuleProperties
+		if props == nil || props.RuleState != sql.DataMaskingRuleStateEnabled {
+			continue
+		}
+
+		if props.SchemaName == nil || props.TableName == nil || props.ColumnName == nil {
+			continue
+		}
+
+		rules[msSqlDatabaseDataMaskingRuleKey(*props.SchemaName, *props.TableName, *props.ColumnName)] = rule
+	}
+
+	return rules, nil
+}
+
+func disableMsSqlDatabaseDataMaskingPolicyRules(ctx context.Context, client *sql.DataMaskingRulesClient, id parse.DatabaseDataMaskingPolicyId, rules []sql.DataMaskingRule) error {
+	for _, rule := range rules {
+		if rule.Name == nil || rule.DataMaskingRuleProperties == nil {
+			continue
+		}
+		props := *rule.DataMaskingRuleProperties
+
+		// to disable a Rule the API requires the Column and Masking Function of the existing Rule
+		parameters := sql.DataMaskingRule{
+			DataMaskingRuleProperties: &sql.DataMaskingRuleProperties{
+				RuleState:       sql.DataMaskingRuleStateDisabled,
+				SchemaName:      props.SchemaName,
+				TableName:       props.TableName,
+				ColumnName:      props.ColumnName,
+				MaskingFunction: props.MaskingFunction,
+			},
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, *rule.Name, parameters); err != nil {
+			return fmt.Errorf("disabling Rule %q for %s: %+v", *rule.Name, id, err)
+		}
+	}
+
+	return nil
+}
+
+func msSqlDatabaseDataMaskingRuleKey(schemaName, tableName, columnName string) string {
+	return strings.ToLower(fmt.Sprintf("%s.%s.%s", schemaName, tableName, columnName))
+}
+
+func msSqlDatabaseDataMaskingRuleName(schemaName, tableName, columnName string) string {
+	return fmt.Sprintf("%s_%s_%s", schemaName, tableName, columnName)
+}
+
+func expandMsSqlDatabaseDataMaskingPolicyExemptedPrincipals(input []interface{}) string {
+	principals := make([]string, 0)
+	for _, v := range input {
+		principals = append(principals, v.(string))
+	}
+
+	return strings.Join(principals, ";")
+}
+
+func flattenMsSqlDatabaseDataMaskingPolicyExemptedPrincipals(input *string) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range strings.Split(*input, ";") {
+		if principal := strings.TrimSpace(v); principal != "" {
+			results = append(results, principal)
+		}
+	}
+
+	return results
+}
+
+func expandMsSqlDatabaseDataMaskingPolicyRules(input []interface{}) (map[string]sql.DataMaskingRule, error) {
+	results := make(map[string]sql.DataMaskingRule)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		schemaName := v["schema_name"].(string)
+		tableName := v["table_name"].(string)
+		columnName := v["column_name"].(string)
+
+		key := msSqlDatabaseDataMaskingRuleKey(schemaName, tableName, columnName)
+		if _, ok := results[key]; ok {
+			return nil, fmt.Errorf("only a single `rule` can be specified for the Column %q (Schema %q / Table %q)", columnName, schemaName, tableName)
+		}
+
+		props := sql.DataMaskingRuleProperties{
+			RuleState:       sql.DataMaskingRuleStateEnabled,
+			SchemaName:      utils.String(schemaName),
+			TableName:       utils.String(tableName),
+			ColumnName:      utils.String(columnName),
+			MaskingFunction: sql.DataMaskingFunction(v["masking_function"].(string)),
+		}
+
+		switch props.MaskingFunction {
+		case sql.DataMaskingFunctionNumber:
+			props.NumberFrom = utils.String(strconv.FormatFloat(v["number_from"].(float64), 'f', -1, 64))
+			props.NumberTo = utils.String(strconv.FormatFloat(v["number_to"].(float64), 'f', -1, 64))
+		case sql.DataMaskingFunctionText:
+			props.PrefixSize = utils.String(strconv.Itoa(v["prefix_size"].(int)))
+			props.SuffixSize = utils.String(strconv.Itoa(v["suffix_size"].(int)))
+			props.ReplacementString = utils.String(v["replacement_string"].(string))
+		}
+
+		results[key] = sql.DataMaskingRule{
+			DataMaskingRuleProperties: &props,
+		}
+	}
+
+	return results, nil
+}
+
+func flattenMsSqlDatabaseDataMaskingPolicyRules(input map[string]sql.DataMaskingRule) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	for _, rule := range input {
+		props := rule.DataMaskingRuleProperties
+		if props == nil {
+			continue
+		}
+
+		// the API returns default values for the fields which don't apply to the Masking Function, so these are omitted
+		numberFrom := 0.0
+		numberTo := 0.0
+		if props.MaskingFunction == sql.DataMaskingFunctionNumber {
+			var err error
+			if numberFrom, err = parseMsSqlDatabaseDataMaskingRuleFloat(props.NumberFrom); err != nil {
+				return nil, fmt.Errorf("parsing `number_from`: %+v", err)
+			}
+			if numberTo, err = parseMsSqlDatabaseDataMaskingRuleFloat(props.NumberTo); err != nil {
+				return nil, fmt.Errorf("parsing `number_to`: %+v", err)
+			}
+		}
+
+		prefixSize := 0
+		suffixSize := 0
+		replacementString := ""
+		if props.MaskingFunction == sql.DataMaskingFunctionText {
+			var err error
+			if prefixSize, err = parseMsSqlDatabaseDataMaskingRuleInt(props.PrefixSize); err != nil {
+				return nil, fmt.Errorf("parsing `prefix_size`: %+v", err)
+			}
+			if suffixSize, err = parseMsSqlDatabaseDataMaskingRuleInt(props.SuffixSize); err != nil {
+				return nil, fmt.Errorf("parsing `suffix_size`: %+v", err)
+			}
+			if props.ReplacementString != nil {
+				replacementString = *props.ReplacementString
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"schema_name":        *props.SchemaName,
+			"table_name":         *props.TableName,
+			"column_name":        *props.ColumnName,
+			"masking_function":   string(props.MaskingFunction),
+			"number_from":        numberFrom,
+			"number_to":          numberTo,
+			"prefix_size":        prefixSize,
+			"suffix_size":        suffixSize,
+			"replacement_string": replacementString,
+		})
+	}
+
+	return results, nil
+}
+
+func parseMsSqlDatabaseDataMaskingRuleFloat(input *string) (float64, error) {
+	if input == nil || *input == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(*input, 64)
+}
+
+func parseMsSqlDatabaseDataMaskingRuleInt(input *string) (int, error) {
+	if input == nil || *input == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(*input)
+}
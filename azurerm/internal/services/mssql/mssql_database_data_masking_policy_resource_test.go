@@ -0,0 +1,222 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlDatabaseDataMaskingPolicyResource struct{}
+
+func TestAccMsSqlDatabaseDataMaskingPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_data_masking_policy", "test")
+	r := MsSqlDatabaseDataMaskingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseDataMaskingPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_data_masking_policy", "test")
+	r := MsSqlDatabaseDataMaskingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlDatabaseDataMaskingPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_data_masking_policy", "test")
+	r := MsSqlDatabaseDataMaskingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("4"),
+				check.That(data.ResourceName).Key("exempted_principals.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseDataMaskingPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_data_masking_policy", "test")
+	r := MsSqlDatabaseDataMaskingPolicyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("4"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlDatabaseDataMaskingPolicyResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseDataMaskingPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.DataMaskingPoliciesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlDatabaseDataMaskingPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_data_masking_policy" "test" {
+  database_id = azurerm_mssql_database.test.id
+
+  rule {
+    schema_name      = "SalesLT"
+    table_name       = "Customer"
+    column_name      = "EmailAddress"
+    masking_function = "Email"
+  }
+}
+`, r.template(data))
+}
+
+func (r MsSqlDatabaseDataMaskingPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_data_masking_policy" "import" {
+  database_id = azurerm_mssql_database_data_masking_policy.test.database_id
+
+  rule {
+    schema_name      = "SalesLT"
+    table_name       = "Customer"
+    column_name      = "EmailAddress"
+    masking_function = "Email"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlDatabaseDataMaskingPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_data_masking_policy" "test" {
+  database_id         = azurerm_mssql_database.test.id
+  enabled             = true
+  exempted_principals = ["dbo", "reporting"]
+
+  rule {
+    schema_name      = "SalesLT"
+    table_name       = "Customer"
+    column_name      = "EmailAddress"
+    masking_function = "Default"
+  }
+
+  rule {
+    schema_name        = "SalesLT"
+    table_name         = "Customer"
+    column_name        = "Phone"
+    masking_function   = "Text"
+    prefix_size        = 2
+    suffix_size        = 4
+    replacement_string = "xxx"
+  }
+
+  rule {
+    schema_name      = "SalesLT"
+    table_name       = "Product"
+    column_name      = "ListPrice"
+    masking_function = "Number"
+    number_from      = 1
+    number_to        = 100
+  }
+
+  rule {
+    schema_name      = "SalesLT"
+    table_name       = "Customer"
+    column_name      = "CompanyName"
+    masking_function = "Default"
+  }
+}
+`, r.template(data))
+}
+
+func (MsSqlDatabaseDataMaskingPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctestsqlserver%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "missadministrator"
+  administrator_login_password = "thisIsKat11"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name        = "acctest-db-%[1]d"
+  server_id   = azurerm_mssql_server.test.id
+  sku_name    = "S1"
+  sample_name = "AdventureWorksLT"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
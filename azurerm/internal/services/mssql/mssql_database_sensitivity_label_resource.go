@@ -0,0 +1,223 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlDatabaseSensitivityLabel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlDatabaseSensitivityLabelCreateUpdate,
+		Read:   resourceMsSqlDatabaseSensitivityLabelRead,
+		Update: resourceMsSqlDatabaseSensitivityLabelCreateUpdate,
+		Delete: resourceMsSqlDatabaseSensitivityLabelDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.DatabaseSensitivityLabelID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseID,
+			},
+
+			"schema_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"table_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"column_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"label_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				AtLeastOneOf: []string{"label_name", "information_type"},
+			},
+
+			// the IDs of the Label and Information Type within the Information Protection Policy
+			"label_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"information_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				AtLeastOneOf: []string{"label_name", "information_type"},
+			},
+
+			"information_type_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"rank": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.SensitivityLabelRankCritical),
+					string(sql.SensitivityLabelRankHigh),
+					string(sql.SensitivityLabelRankLow),
+					string(sql.SensitivityLabelRankMedium),
+					string(sql.SensitivityLabelRankNone),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceMsSqlDatabaseSensitivityLabelCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SensitivityLabelsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewDatabaseSensitivityLabelID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, d.Get("schema_name").(string), d.Get("table_name").(string), d.Get("column_name").(string), string(sql.Current))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, sql.Current)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_database_sensitivity_label", id.ID())
+		}
+	}
+
+	props := sql.SensitivityLabelProperties{
+		Rank: sql.SensitivityLabelRank(d.Get("rank").(string)),
+	}
+
+	if v := d.Get("label_name").(string); v != "" {
+		props.LabelName = utils.String(v)
+	}
+
+	if v := d.Get("label_id").(string); v != "" {
+		props.LabelID = utils.String(v)
+	}
+
+	if v := d.Get("information_type").(string); v != "" {
+		props.InformationType = utils.String(v)
+	}
+
+	if v := d.Get("information_type_id").(string); v != "" {
+		props.InformationTypeID = utils.String(v)
+	}
+
+	parameters := sql.SensitivityLabel{
+		SensitivityLabelProperties: &props,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlDatabaseSensitivityLabelRead(d, meta)
+}
+
+func resourceMsSqlDatabaseSensitivityLabelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SensitivityLabelsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseSensitivityLabelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, sql.Current)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("database_id", parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName).ID())
+	d.Set("schema_name", id.SchemaName)
+	d.Set("table_name", id.TableName)
+	d.Set("column_name", id.ColumnName)
+
+	if props := resp.SensitivityLabelProperties; props != nil {
+		d.Set("label_name", props.LabelName)
+		d.Set("label_id", props.LabelID)
+		d.Set("information_type", props.InformationType)
+		d.Set("information_type_id", props.InformationTypeID)
+		d.Set("rank", string(props.Rank))
+	}
+
+	return nil
+}
+
+func resourceMsSqlDatabaseSensitivityLabelDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SensitivityLabelsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseSensitivityLabelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlDatabaseSensitivityLabelResource struct{}
+
+func TestAccMsSqlDatabaseSensitivityLabel_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_sensitivity_label", "test")
+	r := MsSqlDatabaseSensitivityLabelResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlDatabaseSensitivityLabel_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_sensitivity_label", "test")
+	r := MsSqlDatabaseSensitivityLabelResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlDatabaseSensitivityLabel_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_sensitivity_label", "test")
+	r := MsSqlDatabaseSensitivityLabelResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rank").HasValue("High"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlDatabaseSensitivityLabelResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseSensitivityLabelID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.SensitivityLabelsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, sql.Current)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlDatabaseSensitivityLabelResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_sensitivity_label" "test" {
+  database_id      = azurerm_mssql_database.test.id
+  schema_name      = "SalesLT"
+  table_name       = "Customer"
+  column_name      = "EmailAddress"
+  label_name       = "Confidential"
+  information_type = "Contact Info"
+}
+`, MsSqlDatabaseDataMaskingPolicyResource{}.template(data))
+}
+
+func (r MsSqlDatabaseSensitivityLabelResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_sensitivity_label" "import" {
+  database_id      = azurerm_mssql_database_sensitivity_label.test.database_id
+  schema_name      = azurerm_mssql_database_sensitivity_label.test.schema_name
+  table_name       = azurerm_mssql_database_sensitivity_label.test.table_name
+  column_name      = azurerm_mssql_database_sensitivity_label.test.column_name
+  label_name       = azurerm_mssql_database_sensitivity_label.test.label_name
+  information_type = azurerm_mssql_database_sensitivity_label.test.information_type
+}
+`, r.basic(data))
+}
+
+func (r MsSqlDatabaseSensitivityLabelResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_sensitivity_label" "test" {
+  database_id         = azurerm_mssql_database.test.id
+  schema_name         = "SalesLT"
+  table_name          = "Customer"
+  column_name         = "EmailAddress"
+  label_name          = "Highly Confidential"
+  label_id            = "3302ae7f-b8ac-46bc-97f8-378828781efd"
+  information_type    = "Contact Info"
+  information_type_id = "5c503e21-22c6-81fa-620b-f369b8ec38d1"
+  rank                = "High"
+}
+`, MsSqlDatabaseDataMaskingPolicyResource{}.template(data))
+}
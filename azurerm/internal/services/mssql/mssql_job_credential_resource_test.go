@@ -0,0 +1,133 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlJobCredentialResource struct{}
+
+func TestAccMsSqlJobCredential_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_credential", "test")
+	r := MsSqlJobCredentialResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func TestAccMsSqlJobCredential_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_credential", "test")
+	r := MsSqlJobCredentialResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobCredential_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_credential", "test")
+	r := MsSqlJobCredentialResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password"),
+	})
+}
+
+func (MsSqlJobCredentialResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.JobCredentialID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobCredentialsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.CredentialName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlJobCredentialResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctest-credential-%d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "jobuser"
+  password     = "NCC-1701-D"
+}
+`, MsSqlJobAgentResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MsSqlJobCredentialResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_credential" "import" {
+  name         = azurerm_mssql_job_credential.test.name
+  job_agent_id = azurerm_mssql_job_credential.test.job_agent_id
+  username     = azurerm_mssql_job_credential.test.username
+  password     = azurerm_mssql_job_credential.test.password
+}
+`, r.basic(data))
+}
+
+func (MsSqlJobCredentialResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_credential" "test" {
+  name         = "acctest-credential-%d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+  username     = "jobuser2"
+  password     = "NCC-1701-E"
+}
+`, MsSqlJobAgentResource{}.basic(data), data.RandomInteger)
+}
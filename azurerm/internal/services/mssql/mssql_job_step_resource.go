@@ -0,0 +1,407 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlJobStep() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlJobStepCreateUpdate,
+		Read:   resourceMsSqlJobStepRead,
+		Update: resourceMsSqlJobStepCreateUpdate,
+		Delete: resourceMsSqlJobStepDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.JobStepID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"job_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobID,
+			},
+
+			"job_target_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.JobTargetGroupID,
+			},
+
+			"job_credential_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.JobCredentialID,
+			},
+
+			"sql_script": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// when omitted the Job Step is added as the last Step within the Job
+			"step_index": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"output_target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"database_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.DatabaseID,
+						},
+
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"job_credential_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"schema_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "dbo",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"timeout_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      43200,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"initial_retry_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"maximum_retry_interval_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      120,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_interval_backoff_multiplier": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      2.0,
+				ValidateFunc: validation.FloatAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobStepCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	jobId, err := parse.JobID(d.Get("job_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewJobStepID(jobId.SubscriptionId, jobId.ResourceGroup, jobId.ServerName, jobId.JobAgentName, jobId.Name, d.Get("name").(string))
+
+	// the Steps within a Job are ordered and versioned with the Job, so can't be changed in parallel
+	locks.ByID(jobId.ID())
+	defer locks.UnlockByID(jobId.ID())
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_step", id.ID())
+		}
+	}
+
+	jobAgentId := parse.NewJobAgentID(jobId.SubscriptionId, jobId.ResourceGroup, jobId.ServerName, jobId.JobAgentName)
+
+	targetGroupId, err := parse.JobTargetGroupID(d.Get("job_target_group_id").(string))
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(parse.NewJobAgentID(targetGroupId.SubscriptionId, targetGroupId.ResourceGroup, targetGroupId.ServerName, targetGroupId.JobAgentName).ID(), jobAgentId.ID()) {
+		return fmt.Errorf("`job_target_group_id` must be within the same Job Agent as the Job (%s)", jobAgentId)
+	}
+
+	credentialId, err := parse.JobCredentialID(d.Get("job_credential_id").(string))
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(parse.NewJobAgentID(credentialId.SubscriptionId, credentialId.ResourceGroup, credentialId.ServerName, credentialId.JobAgentName).ID(), jobAgentId.ID()) {
+		return fmt.Errorf("`job_credential_id` must be within the same Job Agent as the Job (%s)", jobAgentId)
+	}
+
+	output, err := expandMsSqlJobStepOutput(d.Get("output_target").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := sql.JobStep{
+		JobStepProperties: &sql.JobStepProperties{
+			TargetGroup: utils.String(targetGroupId.ID()),
+			Credential:  utils.String(credentialId.ID()),
+			Action: &sql.JobStepAction{
+				Type:   sql.TSQL,
+				Source: sql.Inline,
+				Value:  utils.String(d.Get("sql_script").(string)),
+			},
+			Output: output,
+			ExecutionOptions: &sql.JobStepExecutionOptions{
+				TimeoutSeconds:                 utils.Int32(int32(d.Get("timeout_seconds").(int))),
+				RetryAttempts:                  utils.Int32(int32(d.Get("retry_attempts").(int))),
+				InitialRetryIntervalSeconds:    utils.Int32(int32(d.Get("initial_retry_interval_seconds").(int))),
+				MaximumRetryIntervalSeconds:    utils.Int32(int32(d.Get("maximum_retry_interval_seconds").(int))),
+				RetryIntervalBackoffMultiplier: utils.Float(d.Get("retry_interval_backoff_multiplier").(float64)),
+			},
+		},
+	}
+
+	if v, ok := d.GetOk("step_index"); ok {
+		parameters.JobStepProperties.StepID = utils.Int32(int32(v.(int)))
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobStepRead(d, meta)
+}
+
+func resourceMsSqlJobStepRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobStepID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.StepName)
+	d.Set("job_id", parse.NewJobID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName).ID())
+
+	if props := resp.JobStepProperties; props != nil {
+		targetGroupId := ""
+		if props.TargetGroup != nil {
+			parsed, err := parse.JobTargetGroupIDInsensitively(*props.TargetGroup)
+			if err != nil {
+				return err
+			}
+			targetGroupId = parsed.ID()
+		}
+		d.Set("job_target_group_id", targetGroupId)
+
+		credentialId := ""
+		if props.Credential != nil {
+			parsed, err := parse.JobCredentialIDInsensitively(*props.Credential)
+			if err != nil {
+				return err
+			}
+			credentialId = parsed.ID()
+		}
+		d.Set("job_credential_id", credentialId)
+
+		sqlScript := ""
+		if props.Action != nil && props.Action.Value != nil {
+			sqlScript = *props.Action.Value
+		}
+		d.Set("sql_script", sqlScript)
+
+		stepIndex := 0
+		if props.StepID != nil {
+			stepIndex = int(*props.StepID)
+		}
+		d.Set("step_index", stepIndex)
+
+		output, err := flattenMsSqlJobStepOutput(props.Output)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("output_target", output); err != nil {
+			return fmt.Errorf("setting `output_target`: %+v", err)
+		}
+
+		if options := props.ExecutionOptions; options != nil {
+			d.Set("timeout_seconds", options.TimeoutSeconds)
+			d.Set("retry_attempts", options.RetryAttempts)
+			d.Set("initial_retry_interval_seconds", options.InitialRetryIntervalSeconds)
+			d.Set("maximum_retry_interval_seconds", options.MaximumRetryIntervalSeconds)
+			d.Set("retry_interval_backoff_multiplier", options.RetryIntervalBackoffMultiplier)
+		}
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobStepDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobStepsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobStepID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobId := parse.NewJobID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName)
+	locks.ByID(jobId.ID())
+	defer locks.UnlockByID(jobId.ID())
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobStepOutput(input []interface{}) (*sql.JobStepOutput, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	databaseId, err := parse.DatabaseID(v["database_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptionId, err := uuid.FromString(databaseId.SubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the Subscription ID of `output_target.0.database_id`: %+v", err)
+	}
+
+	credentialId, err := parse.JobCredentialID(v["job_credential_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &sql.JobStepOutput{
+		Type:              sql.SQLDatabase,
+		SubscriptionID:    &subscriptionId,
+		ResourceGroupName: utils.String(databaseId.ResourceGroup),
+		ServerName:        utils.String(databaseId.ServerName),
+		DatabaseName:      utils.String(databaseId.Name),
+		SchemaName:        utils.String(v["schema_name"].(string)),
+		TableName:         utils.String(v["table_name"].(string)),
+		Credential:        utils.String(credentialId.ID()),
+	}, nil
+}
+
+func flattenMsSqlJobStepOutput(input *sql.JobStepOutput) ([]interface{}, error) {
+	if input == nil || input.SubscriptionID == nil || input.ResourceGroupName == nil || input.ServerName == nil || input.DatabaseName == nil {
+		return []interface{}{}, nil
+	}
+
+	databaseId := parse.NewDatabaseID(input.SubscriptionID.String(), *input.ResourceGroupName, *input.ServerName, *input.DatabaseName)
+
+	credentialId := ""
+	if input.Credential != nil {
+		parsed, err := parse.JobCredentialIDInsensitively(*input.Credential)
+		if err != nil {
+			return nil, err
+		}
+		credentialId = parsed.ID()
+	}
+
+	schemaName := ""
+	if input.SchemaName != nil {
+		schemaName = *input.SchemaName
+	}
+
+	tableName := ""
+	if input.TableName != nil {
+		tableName = *input.TableName
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"database_id":       databaseId.ID(),
+			"table_name":        tableName,
+			"job_credential_id": credentialId,
+			"schema_name":       schemaName,
+		},
+	}, nil
+}
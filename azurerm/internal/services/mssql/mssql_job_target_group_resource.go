@@ -0,0 +1,340 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlJobTargetGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlJobTargetGroupCreateUpdate,
+		Read:   resourceMsSqlJobTargetGroupRead,
+		Update: resourceMsSqlJobTargetGroupCreateUpdate,
+		Delete: resourceMsSqlJobTargetGroupDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.JobTargetGroupID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+
+			"job_agent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"target": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetTypeSQLDatabase),
+								string(sql.JobTargetTypeSQLElasticPool),
+								string(sql.JobTargetTypeSQLServer),
+								string(sql.JobTargetTypeSQLShardMap),
+							}, false),
+						},
+
+						"server_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"database_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlDatabaseName,
+						},
+
+						"elastic_pool_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlElasticPoolName,
+						},
+
+						"shard_map_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						// the Job Credential used to determine the Databases within a SQL Server, Elastic Pool or Shard Map
+						"job_credential_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"membership_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(sql.Include),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Exclude),
+								string(sql.Include),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobTargetGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	jobAgentId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewJobTargetGroupID(jobAgentId.SubscriptionId, jobAgentId.ResourceGroup, jobAgentId.ServerName, jobAgentId.Name, d.Get("name").(string))
+
+	locks.ByID(jobAgentId.ID())
+	defer locks.UnlockByID(jobAgentId.ID())
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_target_group", id.ID())
+		}
+	}
+
+	members, err := expandMsSqlJobTargetGroupTargets(d.Get("target").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	parameters := sql.JobTargetGroup{
+		JobTargetGroupProperties: &sql.JobTargetGroupProperties{
+			Members: members,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobTargetGroupRead(d, meta)
+}
+
+func resourceMsSqlJobTargetGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TargetGroupName)
+	d.Set("job_agent_id", parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName).ID())
+
+	var members *[]sql.JobTarget
+	if props := resp.JobTargetGroupProperties; props != nil {
+		members = props.Members
+	}
+	targets, err := flattenMsSqlJobTargetGroupTargets(members)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("target", targets); err != nil {
+		return fmt.Errorf("setting `target`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	jobAgentId := parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName)
+	locks.ByID(jobAgentId.ID())
+	defer locks.UnlockByID(jobAgentId.ID())
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobTargetGroupTargets(input []interface{}) (*[]sql.JobTarget, error) {
+	results := make([]sql.JobTarget, 0)
+
+	for i, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		targetType := sql.JobTargetType(v["type"].(string))
+		databaseName := v["database_name"].(string)
+		elasticPoolName := v["elastic_pool_name"].(string)
+		shardMapName := v["shard_map_name"].(string)
+		jobCredentialId := v["job_credential_id"].(string)
+
+		// each type of Target requires a different combination of fields, which the API doesn't describe clearly
+		switch targetType {
+		case sql.JobTargetTypeSQLDatabase:
+			if databaseName == "" {
+				return nil, fmt.Errorf("`target.%d.database_name` must be specified when `type` is `%s`", i, targetType)
+			}
+		case sql.JobTargetTypeSQLElasticPool:
+			if elasticPoolName == "" {
+				return nil, fmt.Errorf("`target.%d.elastic_pool_name` must be specified when `type` is `%s`", i, targetType)
+			}
+		case sql.JobTargetTypeSQLShardMap:
+			if databaseName == "" || shardMapName == "" {
+				return nil, fmt.Errorf("`target.%d.database_name` and `target.%d.shard_map_name` must be specified when `type` is `%s`", i, i, targetType)
+			}
+		}
+
+		// the Job Credential is used to enumerate the Databases to include, so isn't needed when excluding a Target
+		membershipType := sql.JobTargetGroupMembershipType(v["membership_type"].(string))
+		if targetType != sql.JobTargetTypeSQLDatabase && membershipType == sql.Include && jobCredentialId == "" {
+			return nil, fmt.Errorf("`target.%d.job_credential_id` must be specified when `type` is `%s` and `membership_type` is `%s`", i, targetType, membershipType)
+		}
+
+		target := sql.JobTarget{
+			MembershipType: membershipType,
+			Type:           targetType,
+			ServerName:     utils.String(v["server_name"].(string)),
+		}
+
+		if databaseName != "" {
+			target.DatabaseName = utils.String(databaseName)
+		}
+
+		if elasticPoolName != "" {
+			target.ElasticPoolName = utils.String(elasticPoolName)
+		}
+
+		if shardMapName != "" {
+			target.ShardMapName = utils.String(shardMapName)
+		}
+
+		if jobCredentialId != "" {
+			target.RefreshCredential = utils.String(jobCredentialId)
+		}
+
+		results = append(results, target)
+	}
+
+	return &results, nil
+}
+
+func flattenMsSqlJobTargetGroupTargets(input *[]sql.JobTarget) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results, nil
+	}
+
+	for _, item := range *input {
+		serverName := ""
+		if item.ServerName != nil {
+			serverName = *item.ServerName
+		}
+
+		databaseName := ""
+		if item.DatabaseName != nil {
+			databaseName = *item.DatabaseName
+		}
+
+		elasticPoolName := ""
+		if item.ElasticPoolName != nil {
+			elasticPoolName = *item.ElasticPoolName
+		}
+
+		shardMapName := ""
+		if item.ShardMapName != nil {
+			shardMapName = *item.ShardMapName
+		}
+
+		jobCredentialId := ""
+		if item.RefreshCredential != nil && *item.RefreshCredential != "" {
+			id, err := parse.JobCredentialIDInsensitively(*item.RefreshCredential)
+			if err != nil {
+				return nil, err
+			}
+			jobCredentialId = id.ID()
+		}
+
+		results = append(results, map[string]interface{}{
+			"type":              string(item.Type),
+			"server_name":       serverName,
+			"database_name":     databaseName,
+			"elastic_pool_name": elasticPoolName,
+			"shard_map_name":    shardMapName,
+			"job_credential_id": jobCredentialId,
+			"membership_type":   string(item.MembershipType),
+		})
+	}
+
+	return results, nil
+}
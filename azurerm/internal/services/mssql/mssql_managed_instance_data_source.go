@@ -0,0 +1,182 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceMsSqlManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceMsSqlManagedInstanceRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ManagedInstanceName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"vcores": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"storage_size_in_gb": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"administrator_login": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"collation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"minimum_tls_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"proxy_override": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_data_endpoint_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"storage_account_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"timezone_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"dns_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func dataSourceMsSqlManagedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagedInstanceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if err := d.Set("identity", flattenSqlServerIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("collation", props.Collation)
+		d.Set("dns_zone", props.DNSZone)
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("minimum_tls_version", props.MinimalTLSVersion)
+		d.Set("proxy_override", string(props.ProxyOverride))
+		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
+		d.Set("storage_account_type", string(props.StorageAccountType))
+		d.Set("storage_size_in_gb", props.StorageSizeInGB)
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("timezone_id", props.TimezoneID)
+		d.Set("vcores", props.VCores)
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
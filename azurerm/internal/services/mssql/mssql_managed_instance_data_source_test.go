@@ -0,0 +1,42 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type MsSqlManagedInstanceDataSource struct{}
+
+func TestAccDataSourceMsSqlManagedInstance_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_managed_instance", "test")
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: MsSqlManagedInstanceDataSource{}.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue(fmt.Sprintf("acctestsqlmi%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("location").HasValue(data.Locations.Primary),
+				check.That(data.ResourceName).Key("sku_name").HasValue("GP_Gen5"),
+				check.That(data.ResourceName).Key("administrator_login").Exists(),
+				check.That(data.ResourceName).Key("subnet_id").Exists(),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlManagedInstanceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_mssql_managed_instance" "test" {
+  name                = azurerm_mssql_managed_instance.test.name
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, MsSqlManagedInstanceResource{}.basic(data))
+}
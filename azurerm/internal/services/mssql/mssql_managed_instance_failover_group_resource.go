@@ -0,0 +1,337 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlManagedInstanceFailoverGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Read:   resourceMsSqlManagedInstanceFailoverGroupRead,
+		Update: resourceMsSqlManagedInstanceFailoverGroupCreateUpdate,
+		Delete: resourceMsSqlManagedInstanceFailoverGroupDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.InstanceFailoverGroupID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateMsSqlFailoverGroupName,
+			},
+
+			// the Failover Group is created within the location of the primary Managed Instance
+			"location": location.Schema(),
+
+			"managed_instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"partner_managed_instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"read_write_endpoint_failover_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.Automatic),
+								string(sql.Manual),
+							}, false),
+						},
+
+						"grace_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(60),
+						},
+					},
+				},
+			},
+
+			"readonly_endpoint_failover_policy_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"partner_region": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	instancesClient := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	instanceId, err := parse.ManagedInstanceID(d.Get("managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	partnerId, err := parse.ManagedInstanceID(d.Get("partner_managed_instance_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewInstanceFailoverGroupID(instanceId.SubscriptionId, instanceId.ResourceGroup, location.Normalize(d.Get("location").(string)), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_managed_instance_failover_group", id.ID())
+		}
+	}
+
+	// the location of the partner region is required, which is the location of the partner Managed Instance
+	partner, err := instancesClient.Get(ctx, partnerId.ResourceGroup, partnerId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving partner %s: %+v", *partnerId, err)
+	}
+
+	readOnlyFailoverPolicy := sql.ReadOnlyEndpointFailoverPolicyDisabled
+	if d.Get("readonly_endpoint_failover_policy_enabled").(bool) {
+		readOnlyFailoverPolicy = sql.ReadOnlyEndpointFailoverPolicyEnabled
+	}
+
+	parameters := sql.InstanceFailoverGroup{
+		InstanceFailoverGroupProperties: &sql.InstanceFailoverGroupProperties{
+			ReadOnlyEndpoint: &sql.InstanceFailoverGroupReadOnlyEndpoint{
+				FailoverPolicy: readOnlyFailoverPolicy,
+			},
+			ReadWriteEndpoint: expandMsSqlManagedInstanceFailoverGroupReadWriteEndpoint(d.Get("read_write_endpoint_failover_policy").([]interface{})),
+			PartnerRegions: &[]sql.PartnerRegionInfo{
+				{
+					Location: partner.Location,
+				},
+			},
+			ManagedInstancePairs: &[]sql.ManagedInstancePairInfo{
+				{
+					PrimaryManagedInstanceID: utils.String(instanceId.ID()),
+					PartnerManagedInstanceID: utils.String(partnerId.ID()),
+				},
+			},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.LocationName, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlManagedInstanceFailoverGroupRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("location", location.Normalize(id.LocationName))
+
+	if props := resp.InstanceFailoverGroupProperties; props != nil {
+		if err := d.Set("read_write_endpoint_failover_policy", flattenMsSqlManagedInstanceFailoverGroupReadWriteEndpoint(props.ReadWriteEndpoint)); err != nil {
+			return fmt.Errorf("setting `read_write_endpoint_failover_policy`: %+v", err)
+		}
+
+		readOnlyFailoverPolicyEnabled := false
+		if props.ReadOnlyEndpoint != nil {
+			readOnlyFailoverPolicyEnabled = props.ReadOnlyEndpoint.FailoverPolicy == sql.ReadOnlyEndpointFailoverPolicyEnabled
+		}
+		d.Set("readonly_endpoint_failover_policy_enabled", readOnlyFailoverPolicyEnabled)
+
+		if pairs := props.ManagedInstancePairs; pairs != nil && len(*pairs) > 0 {
+			pair := (*pairs)[0]
+
+			// the Managed Instance IDs are returned with a normalized casing, so we parse these insensitively
+			if pair.PrimaryManagedInstanceID != nil {
+				primaryId, err := parse.ManagedInstanceIDInsensitively(*pair.PrimaryManagedInstanceID)
+				if err != nil {
+					return err
+				}
+				d.Set("managed_instance_id", primaryId.ID())
+			}
+
+			if pair.PartnerManagedInstanceID != nil {
+				partnerId, err := parse.ManagedInstanceIDInsensitively(*pair.PartnerManagedInstanceID)
+				if err != nil {
+					return err
+				}
+				d.Set("partner_managed_instance_id", partnerId.ID())
+			}
+		}
+
+		if err := d.Set("partner_region", flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(props.PartnerRegions)); err != nil {
+			return fmt.Errorf("setting `partner_region`: %+v", err)
+		}
+
+		d.Set("role", string(props.ReplicationRole))
+	}
+
+	return nil
+}
+
+func resourceMsSqlManagedInstanceFailoverGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.InstanceFailoverGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.InstanceFailoverGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlManagedInstanceFailoverGroupReadWriteEndpoint(input []interface{}) *sql.InstanceFailoverGroupReadWriteEndpoint {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+	endpoint := sql.InstanceFailoverGroupReadWriteEndpoint{
+		FailoverPolicy: sql.ReadWriteEndpointFailoverPolicy(policy["mode"].(string)),
+	}
+
+	// the grace period can only be specified when the Failover Policy is Automatic
+	if endpoint.FailoverPolicy == sql.Automatic {
+		if v := policy["grace_minutes"].(int); v > 0 {
+			endpoint.FailoverWithDataLossGracePeriodMinutes = utils.Int32(int32(v))
+		}
+	}
+
+	return &endpoint
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupReadWriteEndpoint(input *sql.InstanceFailoverGroupReadWriteEndpoint) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	graceMinutes := 0
+	if input.FailoverWithDataLossGracePeriodMinutes != nil {
+		graceMinutes = int(*input.FailoverWithDataLossGracePeriodMinutes)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":          string(input.FailoverPolicy),
+			"grace_minutes": graceMinutes,
+		},
+	}
+}
+
+func flattenMsSqlManagedInstanceFailoverGroupPartnerRegions(input *[]sql.PartnerRegionInfo) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, region := range *input {
+		results = append(results, map[string]interface{}{
+			"location": location.NormalizeNilable(region.Location),
+			"role":     string(region.ReplicationRole),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,276 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type MsSqlManagedInstanceFailoverGroupResource struct{}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("role").HasValue("Primary"),
+				check.That(data.ResourceName).Key("partner_region.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlManagedInstanceFailoverGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_instance_failover_group", "test")
+	r := MsSqlManagedInstanceFailoverGroupResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("read_write_endpoint_failover_policy.0.mode").HasValue("Manual"),
+				check.That(data.ResourceName).Key("readonly_endpoint_failover_policy_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlManagedInstanceFailoverGroupResource) Exists(ctx context.Context, client *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.InstanceFailoverGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.InstanceFailoverGroupsClient.Get(ctx, id.ResourceGroup, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                        = "acctest-fog-%d"
+  location                    = azurerm_mssql_managed_instance.primary.location
+  managed_instance_id         = azurerm_mssql_managed_instance.primary.id
+  partner_managed_instance_id = azurerm_mssql_managed_instance.secondary.id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "import" {
+  name                        = azurerm_mssql_managed_instance_failover_group.test.name
+  location                    = azurerm_mssql_managed_instance_failover_group.test.location
+  managed_instance_id         = azurerm_mssql_managed_instance_failover_group.test.managed_instance_id
+  partner_managed_instance_id = azurerm_mssql_managed_instance_failover_group.test.partner_managed_instance_id
+
+  read_write_endpoint_failover_policy {
+    mode          = "Automatic"
+    grace_minutes = 60
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_managed_instance_failover_group" "test" {
+  name                                      = "acctest-fog-%d"
+  location                                  = azurerm_mssql_managed_instance.primary.location
+  managed_instance_id                       = azurerm_mssql_managed_instance.primary.id
+  partner_managed_instance_id               = azurerm_mssql_managed_instance.secondary.id
+  readonly_endpoint_failover_policy_enabled = true
+
+  read_write_endpoint_failover_policy {
+    mode = "Manual"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedInstanceFailoverGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+%[4]s
+
+%[5]s
+
+resource "azurerm_virtual_network_peering" "primary" {
+  name                      = "acctest-peering-primary-%[1]d"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.primary.name
+  remote_virtual_network_id = azurerm_virtual_network.secondary.id
+}
+
+resource "azurerm_virtual_network_peering" "secondary" {
+  name                      = "acctest-peering-secondary-%[1]d"
+  resource_group_name       = azurerm_resource_group.test.name
+  virtual_network_name      = azurerm_virtual_network.secondary.name
+  remote_virtual_network_id = azurerm_virtual_network.primary.id
+}
+
+resource "azurerm_mssql_managed_instance" "primary" {
+  name                         = "acctestsqlmi-primary-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = "%[2]s"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 4
+  storage_size_in_gb           = 32
+  license_type                 = "BasePrice"
+  subnet_id                    = azurerm_subnet.primary.id
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.primary,
+    azurerm_subnet_route_table_association.primary,
+  ]
+}
+
+resource "azurerm_mssql_managed_instance" "secondary" {
+  name                         = "acctestsqlmi-secondary-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = "%[3]s"
+  sku_name                     = "GP_Gen5"
+  vcores                       = 4
+  storage_size_in_gb           = 32
+  license_type                 = "BasePrice"
+  subnet_id                    = azurerm_subnet.secondary.id
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+  dns_zone_partner_id          = azurerm_mssql_managed_instance.primary.id
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.secondary,
+    azurerm_subnet_route_table_association.secondary,
+  ]
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary,
+		r.network(data, "primary", data.Locations.Primary, "10.0"),
+		r.network(data, "secondary", data.Locations.Secondary, "10.1"))
+}
+
+func (MsSqlManagedInstanceFailoverGroupResource) network(data acceptance.TestData, name, location, prefix string) string {
+	return fmt.Sprintf(`
+resource "azurerm_virtual_network" "%[2]s" {
+  name                = "acctest-vnet-%[2]s-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[3]s"
+  address_space       = ["%[4]s.0.0/16"]
+}
+
+resource "azurerm_subnet" "%[2]s" {
+  name                 = "acctest-subnet-%[2]s-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.%[2]s.name
+  address_prefixes     = ["%[4]s.0.0/24"]
+
+  delegation {
+    name = "managedinstancedelegation"
+
+    service_delegation {
+      name = "Microsoft.Sql/managedInstances"
+      actions = [
+        "Microsoft.Network/virtualNetworks/subnets/join/action",
+        "Microsoft.Network/virtualNetworks/subnets/prepareNetworkPolicies/action",
+        "Microsoft.Network/virtualNetworks/subnets/unprepareNetworkPolicies/action",
+      ]
+    }
+  }
+}
+
+resource "azurerm_network_security_group" "%[2]s" {
+  name                = "acctest-nsg-%[2]s-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%[3]s"
+}
+
+resource "azurerm_subnet_network_security_group_association" "%[2]s" {
+  subnet_id                 = azurerm_subnet.%[2]s.id
+  network_security_group_id = azurerm_network_security_group.%[2]s.id
+}
+
+resource "azurerm_route_table" "%[2]s" {
+  name                          = "acctest-rt-%[2]s-%[1]d"
+  resource_group_name           = azurerm_resource_group.test.name
+  location                      = "%[3]s"
+  disable_bgp_route_propagation = false
+}
+
+resource "azurerm_subnet_route_table_association" "%[2]s" {
+  subnet_id      = azurerm_subnet.%[2]s.id
+  route_table_id = azurerm_route_table.%[2]s.id
+}
+`, data.RandomInteger, name, location, prefix)
+}
@@ -0,0 +1,377 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceMsSqlManagedInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlManagedInstanceCreateUpdate,
+		Read:   resourceMsSqlManagedInstanceRead,
+		Update: resourceMsSqlManagedInstanceCreateUpdate,
+		Delete: resourceMsSqlManagedInstanceDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ManagedInstanceID(id)
+			return err
+		}),
+
+		// provisioning a SQL Managed Instance (or changing the SKU/vCores) can take several hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(24 * time.Hour),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(24 * time.Hour),
+			Delete: schema.DefaultTimeout(24 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ManagedInstanceName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"location": location.Schema(),
+
+			"sku_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"GP_Gen5",
+					"BC_Gen5",
+				}, false),
+			},
+
+			"vcores": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.IntInSlice([]int{
+					4,
+					8,
+					16,
+					24,
+					32,
+					40,
+					64,
+					80,
+				}),
+			},
+
+			"storage_size_in_gb": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.IntBetween(32, 8192),
+					validation.IntDivisibleBy(32),
+				),
+			},
+
+			"license_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ManagedInstanceLicenseTypeBasePrice),
+					string(sql.ManagedInstanceLicenseTypeLicenseIncluded),
+				}, false),
+			},
+
+			"subnet_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"administrator_login": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"administrator_login_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"collation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SQL_Latin1_General_CP1_CI_AS",
+				ValidateFunc: validate.DatabaseCollation(),
+			},
+
+			// the DNS Zone Partner is only used during creation and isn't returned from the API, as such
+			// this isn't ForceNew since it'd otherwise force the recreation of an imported Managed Instance
+			"dns_zone_partner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ManagedInstanceID,
+			},
+
+			"identity": msSqlManagedInstanceIdentity{}.Schema(),
+
+			"minimum_tls_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "1.2",
+				ValidateFunc: validation.StringInSlice([]string{
+					"1.0",
+					"1.1",
+					"1.2",
+				}, false),
+			},
+
+			"proxy_override": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(sql.ManagedInstanceProxyOverrideDefault),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.ManagedInstanceProxyOverrideDefault),
+					string(sql.ManagedInstanceProxyOverrideProxy),
+					string(sql.ManagedInstanceProxyOverrideRedirect),
+				}, false),
+			},
+
+			"public_data_endpoint_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"storage_account_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(sql.GRS),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.GRS),
+					string(sql.LRS),
+					string(sql.ZRS),
+				}, false),
+			},
+
+			"timezone_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "UTC",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"dns_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceMsSqlManagedInstanceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewManagedInstanceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_managed_instance", id.ID())
+		}
+	}
+
+	parameters := sql.ManagedInstance{
+		Location: utils.String(location.Normalize(d.Get("location").(string))),
+		Sku: &sql.Sku{
+			Name: utils.String(d.Get("sku_name").(string)),
+		},
+		ManagedInstanceProperties: &sql.ManagedInstanceProperties{
+			AdministratorLogin:        utils.String(d.Get("administrator_login").(string)),
+			Collation:                 utils.String(d.Get("collation").(string)),
+			LicenseType:               sql.ManagedInstanceLicenseType(d.Get("license_type").(string)),
+			MinimalTLSVersion:         utils.String(d.Get("minimum_tls_version").(string)),
+			ProxyOverride:             sql.ManagedInstanceProxyOverride(d.Get("proxy_override").(string)),
+			PublicDataEndpointEnabled: utils.Bool(d.Get("public_data_endpoint_enabled").(bool)),
+			StorageAccountType:        sql.StorageAccountType(d.Get("storage_account_type").(string)),
+			StorageSizeInGB:           utils.Int32(int32(d.Get("storage_size_in_gb").(int))),
+			SubnetID:                  utils.String(d.Get("subnet_id").(string)),
+			TimezoneID:                utils.String(d.Get("timezone_id").(string)),
+			VCores:                    utils.Int32(int32(d.Get("vcores").(int))),
+		},
+		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+	}
+
+	managedInstanceIdentity, err := expandMsSqlManagedInstanceIdentity(d.Get("identity").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `identity`: %+v", err)
+	}
+	parameters.Identity = managedInstanceIdentity
+
+	if d.HasChange("administrator_login_password") {
+		parameters.ManagedInstanceProperties.AdministratorLoginPassword = utils.String(d.Get("administrator_login_password").(string))
+	}
+
+	if v := d.Get("dns_zone_partner_id").(string); v != "" {
+		parameters.ManagedInstanceProperties.DNSZonePartner = utils.String(v)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlManagedInstanceRead(d, meta)
+}
+
+func resourceMsSqlManagedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("location", location.NormalizeNilable(resp.Location))
+
+	skuName := ""
+	if resp.Sku != nil && resp.Sku.Name != nil {
+		skuName = *resp.Sku.Name
+	}
+	d.Set("sku_name", skuName)
+
+	if err := d.Set("identity", flattenMsSqlManagedInstanceIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
+
+	if props := resp.ManagedInstanceProperties; props != nil {
+		d.Set("administrator_login", props.AdministratorLogin)
+		d.Set("collation", props.Collation)
+		d.Set("dns_zone", props.DNSZone)
+		d.Set("fqdn", props.FullyQualifiedDomainName)
+		d.Set("license_type", string(props.LicenseType))
+		d.Set("minimum_tls_version", props.MinimalTLSVersion)
+		d.Set("proxy_override", string(props.ProxyOverride))
+		d.Set("public_data_endpoint_enabled", props.PublicDataEndpointEnabled)
+		d.Set("storage_account_type", string(props.StorageAccountType))
+		d.Set("storage_size_in_gb", props.StorageSizeInGB)
+		d.Set("subnet_id", props.SubnetID)
+		d.Set("timezone_id", props.TimezoneID)
+		d.Set("vcores", props.VCores)
+
+		// the DNS Zone Partner is only used during creation and isn't returned from the API
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceMsSqlManagedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.ManagedInstancesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+type msSqlManagedInstanceIdentity = identity.SystemAssigned
+
+func expandMsSqlManagedInstanceIdentity(input []interface{}) (*sql.ResourceIdentity, error) {
+	config, err := msSqlManagedInstanceIdentity{}.Expand(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// when the block is omitted the type is `None`, so that the Identity is removed
+	return &sql.ResourceIdentity{
+		Type: sql.IdentityType(config.Type),
+	}, nil
+}
+
+func flattenMsSqlManagedInstanceIdentity(input *sql.ResourceIdentity) []interface{} {
+	var config *identity.ExpandedConfig
+	if input != nil {
+		config = &identity.ExpandedConfig{
+			Type: string(input.Type),
+		}
+		if input.PrincipalID != nil {
+			config.PrincipalId = utils.String(input.PrincipalID.String())
+		}
+		if input.TenantID != nil {
+			config.TenantId = utils.String(input.TenantID.String())
+		}
+	}
+	return msSqlManagedInstanceIdentity{}.Flatten(config)
+}
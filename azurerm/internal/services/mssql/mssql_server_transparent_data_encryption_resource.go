@@ -0,0 +1,330 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
+	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	// a SQL Server only has a single Encryption Protector, which is always named `current`
+	msSqlServerEncryptionProtectorName = "current"
+
+	// the name of the built-in Server Key used when the Encryption Protector is Service Managed
+	msSqlServerServiceManagedKeyName = "ServiceManaged"
+)
+
+func resourceMsSqlServerTransparentDataEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMsSqlServerTransparentDataEncryptionCreateUpdate,
+		Read:   resourceMsSqlServerTransparentDataEncryptionRead,
+		Update: resourceMsSqlServerTransparentDataEncryptionCreateUpdate,
+		Delete: resourceMsSqlServerTransparentDataEncryptionDelete,
+
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.EncryptionProtectorID(id)
+			return err
+		}),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"server_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ServerID,
+			},
+
+			// when omitted the Encryption Protector is Service Managed
+			"key_vault_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+			},
+
+			// the API has no concept of auto-rotation for SQL Servers, so this is handled by checking
+			// for a newer version of the Key Vault Key during the plan and rotating to it when found
+			"auto_rotation_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"current_key_vault_key_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: resourceMsSqlServerTransparentDataEncryptionCustomizeDiff,
+	}
+}
+
+func resourceMsSqlServerTransparentDataEncryptionCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("key_vault_key_id") {
+		if err := d.SetNewComputed("current_key_vault_key_id"); err != nil {
+			return err
+		}
+	}
+
+	// the Key may not exist yet, in which case the latest version is determined during the apply
+	if !d.Get("auto_rotation_enabled").(bool) || !d.NewValueKnown("key_vault_key_id") {
+		return nil
+	}
+
+	keyVaultKeyId := d.Get("key_vault_key_id").(string)
+	if keyVaultKeyId == "" {
+		return fmt.Errorf("`key_vault_key_id` must be specified when `auto_rotation_enabled` is `true`")
+	}
+
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(keyVaultKeyId)
+	if err != nil {
+		return fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+	}
+	if keyId.Version != "" {
+		return fmt.Errorf("`key_vault_key_id` must be a versionless Key ID when `auto_rotation_enabled` is `true`")
+	}
+
+	if d.Id() == "" || d.HasChange("key_vault_key_id") {
+		return nil
+	}
+
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, 5*time.Minute)
+	defer cancel()
+
+	latestKeyId, err := resolveMsSqlServerTransparentDataEncryptionKey(ctx, client, keyVaultKeyId)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(latestKeyId.ID(), d.Get("current_key_vault_key_id").(string)) {
+		log.Printf("[DEBUG] Key %q has a newer version %q - rotating the Encryption Protector", keyId.ID(), latestKeyId.Version)
+		return d.SetNewComputed("current_key_vault_key_id")
+	}
+
+	return nil
+}
+
+func resourceMsSqlServerTransparentDataEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.EncryptionProtectorsClient
+	serverKeysClient := meta.(*clients.Client).MSSQL.ServerKeysClient
+	keyVaultClient := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	serverId, err := parse.ServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewEncryptionProtectorID(serverId.SubscriptionId, serverId.ResourceGroup, serverId.Name, msSqlServerEncryptionProtectorName)
+	if d.IsNewResource() {
+		// the Encryption Protector always exists, so we can only check whether it's been switched to a Key Vault Key
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName)
+		if err != nil {
+			return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+		}
+
+		if props := existing.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault {
+			return tf.ImportAsExistsError("azurerm_mssql_server_transparent_data_encryption", id.ID())
+		}
+	}
+
+	serverKeyName := msSqlServerServiceManagedKeyName
+	serverKeyType := sql.ServiceManaged
+	if v := d.Get("key_vault_key_id").(string); v != "" {
+		keyId, err := resolveMsSqlServerTransparentDataEncryptionKey(ctx, keyVaultClient, v)
+		if err != nil {
+			return err
+		}
+
+		serverKeyName, err = msSqlServerKeyNameForKeyVaultKey(*keyId)
+		if err != nil {
+			return err
+		}
+		serverKeyType = sql.AzureKeyVault
+
+		serverKey := sql.ServerKey{
+			ServerKeyProperties: &sql.ServerKeyProperties{
+				ServerKeyType: sql.AzureKeyVault,
+				URI:           utils.String(keyId.ID()),
+			},
+		}
+
+		future, err := serverKeysClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, serverKeyName, serverKey)
+		if err != nil {
+			return fmt.Errorf("registering Key %q as a Server Key for %s: %+v", keyId.ID(), *serverId, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, serverKeysClient.Client); err != nil {
+			return fmt.Errorf("waiting for Key %q to be registered as a Server Key for %s: %+v", keyId.ID(), *serverId, err)
+		}
+	}
+
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(serverKeyName),
+			ServerKeyType: serverKeyType,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for creation/update of %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlServerTransparentDataEncryptionRead(d, meta)
+}
+
+func resourceMsSqlServerTransparentDataEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.EncryptionProtectorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EncryptionProtectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("server_id", parse.NewServerID(id.SubscriptionId, id.ResourceGroup, id.ServerName).ID())
+
+	keyVaultKeyId := ""
+	currentKeyVaultKeyId := ""
+	if props := resp.EncryptionProtectorProperties; props != nil && props.ServerKeyType == sql.AzureKeyVault && props.URI != nil {
+		keyId, err := keyVaultParse.ParseNestedItemID(*props.URI)
+		if err != nil {
+			return fmt.Errorf("parsing Key Vault Key ID %q: %+v", *props.URI, err)
+		}
+		currentKeyVaultKeyId = keyId.ID()
+		keyVaultKeyId = currentKeyVaultKeyId
+
+		// a versionless Key ID uses the latest version of the Key, so it's retained whilst it refers to the same Key
+		if existing, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_key_id").(string)); err == nil && existing.Version == "" {
+			if strings.EqualFold(existing.KeyVaultBaseUrl, keyId.KeyVaultBaseUrl) && strings.EqualFold(existing.Name, keyId.Name) {
+				keyVaultKeyId = existing.ID()
+			}
+		}
+	}
+	d.Set("key_vault_key_id", keyVaultKeyId)
+	d.Set("current_key_vault_key_id", currentKeyVaultKeyId)
+
+	// `auto_rotation_enabled` isn't returned from the API, so we retain the value from the config
+
+	return nil
+}
+
+func resourceMsSqlServerTransparentDataEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.EncryptionProtectorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.EncryptionProtectorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the Encryption Protector can't be deleted, so instead we revert to the Service Managed Key. The Server Key
+	// for the Key Vault Key is intentionally left registered, since it's needed to restore existing backups.
+	parameters := sql.EncryptionProtector{
+		EncryptionProtectorProperties: &sql.EncryptionProtectorProperties{
+			ServerKeyName: utils.String(msSqlServerServiceManagedKeyName),
+			ServerKeyType: sql.ServiceManaged,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, parameters)
+	if err != nil {
+		return fmt.Errorf("reverting %s to a Service Managed Key: %+v", *id, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for %s to revert to a Service Managed Key: %+v", *id, err)
+	}
+
+	return nil
+}
+
+// resolveMsSqlServerTransparentDataEncryptionKey parses the specified Key Vault Key ID, looking up the
+// latest version of the Key when a versionless ID is specified
+func resolveMsSqlServerTransparentDataEncryptionKey(ctx context.Context, client *keyvaultmgmt.BaseClient, input string) (*keyVaultParse.NestedItemId, error) {
+	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `key_vault_key_id`: %+v", err)
+	}
+
+	if keyId.NestedItemType != "keys" {
+		return nil, fmt.Errorf("`key_vault_key_id` must be the ID of a Key Vault Key but got a %q ID", keyId.NestedItemType)
+	}
+
+	if keyId.Version != "" {
+		return keyId, nil
+	}
+
+	resp, err := client.GetKey(ctx, keyId.KeyVaultBaseUrl, keyId.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the latest version of Key %q (Key Vault %q): %+v", keyId.Name, keyId.KeyVaultBaseUrl, err)
+	}
+
+	if resp.Key == nil || resp.Key.Kid == nil {
+		return nil, fmt.Errorf("retrieving the latest version of Key %q (Key Vault %q): `kid` was nil", keyId.Name, keyId.KeyVaultBaseUrl)
+	}
+
+	return keyVaultParse.ParseNestedItemID(*resp.Key.Kid)
+}
+
+// msSqlServerKeyNameForKeyVaultKey returns the name of the Server Key for a Key Vault Key,
+// which the API requires to be in the format `{vaultName}_{keyName}_{keyVersion}`
+func msSqlServerKeyNameForKeyVaultKey(keyId keyVaultParse.NestedItemId) (string, error) {
+	keyVaultUrl, err := url.Parse(keyId.KeyVaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("parsing Key Vault URL %q: %+v", keyId.KeyVaultBaseUrl, err)
+	}
+
+	vaultName := strings.Split(keyVaultUrl.Host, ".")[0]
+	if vaultName == "" {
+		return "", fmt.Errorf("determining the Key Vault name from %q", keyId.KeyVaultBaseUrl)
+	}
+
+	return fmt.Sprintf("%s_%s_%s", vaultName, keyId.Name, keyId.Version), nil
+}
@@ -0,0 +1,136 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DatabaseDataMaskingPolicyId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	ServerName            string
+	DatabaseName          string
+	DataMaskingPolicyName string
+}
+
+func NewDatabaseDataMaskingPolicyID(subscriptionId, resourceGroup, serverName, databaseName, dataMaskingPolicyName string) DatabaseDataMaskingPolicyId {
+	return DatabaseDataMaskingPolicyId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		ServerName:            serverName,
+		DatabaseName:          databaseName,
+		DataMaskingPolicyName: dataMaskingPolicyName,
+	}
+}
+
+func (id DatabaseDataMaskingPolicyId) String() string {
+	segments := []string{
+		fmt.Sprintf("Data Masking Policy Name %q", id.DataMaskingPolicyName),
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Database Data Masking Policy", segmentsStr)
+}
+
+func (id DatabaseDataMaskingPolicyId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/dataMaskingPolicies/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.DataMaskingPolicyName)
+}
+
+// DatabaseDataMaskingPolicyID parses a DatabaseDataMaskingPolicy ID into an DatabaseDataMaskingPolicyId struct
+func DatabaseDataMaskingPolicyID(input string) (*DatabaseDataMaskingPolicyId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseDataMaskingPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.DataMaskingPolicyName, err = id.PopSegment("dataMaskingPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DatabaseDataMaskingPolicyIDInsensitively parses an DatabaseDataMaskingPolicy ID into an DatabaseDataMaskingPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DatabaseDataMaskingPolicyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DatabaseDataMaskingPolicyIDInsensitively(input string) (*DatabaseDataMaskingPolicyId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseDataMaskingPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.DataMaskingPolicyName, err = id.PopSegmentInsensitively("dataMaskingPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDatabaseDataMaskingPolicyID parses the specified DatabaseDataMaskingPolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDatabaseDataMaskingPolicyID(input string) (string, error) {
+	id, err := DatabaseDataMaskingPolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -0,0 +1,163 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type DatabaseSensitivityLabelId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	ServerName           string
+	DatabaseName         string
+	SchemaName           string
+	TableName            string
+	ColumnName           string
+	SensitivityLabelName string
+}
+
+func NewDatabaseSensitivityLabelID(subscriptionId, resourceGroup, serverName, databaseName, schemaName, tableName, columnName, sensitivityLabelName string) DatabaseSensitivityLabelId {
+	return DatabaseSensitivityLabelId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		ServerName:           serverName,
+		DatabaseName:         databaseName,
+		SchemaName:           schemaName,
+		TableName:            tableName,
+		ColumnName:           columnName,
+		SensitivityLabelName: sensitivityLabelName,
+	}
+}
+
+func (id DatabaseSensitivityLabelId) String() string {
+	segments := []string{
+		fmt.Sprintf("Sensitivity Label Name %q", id.SensitivityLabelName),
+		fmt.Sprintf("Column Name %q", id.ColumnName),
+		fmt.Sprintf("Table Name %q", id.TableName),
+		fmt.Sprintf("Schema Name %q", id.SchemaName),
+		fmt.Sprintf("Database Name %q", id.DatabaseName),
+		fmt.Sprintf("Server Name %q", id.ServerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Database Sensitivity Label", segmentsStr)
+}
+
+func (id DatabaseSensitivityLabelId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/servers/%s/databases/%s/schemas/%s/tables/%s/columns/%s/sensitivityLabels/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SchemaName, id.TableName, id.ColumnName, id.SensitivityLabelName)
+}
+
+// DatabaseSensitivityLabelID parses a DatabaseSensitivityLabel ID into an DatabaseSensitivityLabelId struct
+func DatabaseSensitivityLabelID(input string) (*DatabaseSensitivityLabelId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseSensitivityLabelId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.SchemaName, err = id.PopSegment("schemas"); err != nil {
+		return nil, err
+	}
+	if resourceId.TableName, err = id.PopSegment("tables"); err != nil {
+		return nil, err
+	}
+	if resourceId.ColumnName, err = id.PopSegment("columns"); err != nil {
+		return nil, err
+	}
+	if resourceId.SensitivityLabelName, err = id.PopSegment("sensitivityLabels"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// DatabaseSensitivityLabelIDInsensitively parses an DatabaseSensitivityLabel ID into an DatabaseSensitivityLabelId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DatabaseSensitivityLabelID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DatabaseSensitivityLabelIDInsensitively(input string) (*DatabaseSensitivityLabelId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseSensitivityLabelId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.SchemaName, err = id.PopSegmentInsensitively("schemas"); err != nil {
+		return nil, err
+	}
+	if resourceId.TableName, err = id.PopSegmentInsensitively("tables"); err != nil {
+		return nil, err
+	}
+	if resourceId.ColumnName, err = id.PopSegmentInsensitively("columns"); err != nil {
+		return nil, err
+	}
+	if resourceId.SensitivityLabelName, err = id.PopSegmentInsensitively("sensitivityLabels"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDatabaseSensitivityLabelID parses the specified DatabaseSensitivityLabel ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDatabaseSensitivityLabelID(input string) (string, error) {
+	id, err := DatabaseSensitivityLabelIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
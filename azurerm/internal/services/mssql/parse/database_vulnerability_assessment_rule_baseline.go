@@ -91,3 +91,64 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 
 	return &resourceId, nil
 }
+
+// DatabaseVulnerabilityAssessmentRuleBaselineIDInsensitively parses an DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct, insensitively
+// This should only be used to parse an ID for rewriting, the DatabaseVulnerabilityAssessmentRuleBaselineID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func DatabaseVulnerabilityAssessmentRuleBaselineIDInsensitively(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseVulnerabilityAssessmentRuleBaselineId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
+		return nil, err
+	}
+	if resourceId.VulnerabilityAssessmentName, err = id.PopSegmentInsensitively("vulnerabilityAssessments"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleName, err = id.PopSegmentInsensitively("rules"); err != nil {
+		return nil, err
+	}
+	if resourceId.BaselineName, err = id.PopSegmentInsensitively("baselines"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeDatabaseVulnerabilityAssessmentRuleBaselineID parses the specified DatabaseVulnerabilityAssessmentRuleBaseline ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeDatabaseVulnerabilityAssessmentRuleBaselineID(input string) (string, error) {
+	id, err := DatabaseVulnerabilityAssessmentRuleBaselineIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicy
 
 	return &resourceId, nil
 }
+
+// ServerExtendedAuditingPolicyIDInsensitively parses an ServerExtendedAuditingPolicy ID into an ServerExtendedAuditingPolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ServerExtendedAuditingPolicyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ServerExtendedAuditingPolicyIDInsensitively(input string) (*ServerExtendedAuditingPolicyId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServerExtendedAuditingPolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.ExtendedAuditingSettingName, err = id.PopSegmentInsensitively("extendedAuditingSettings"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeServerExtendedAuditingPolicyID parses the specified ServerExtendedAuditingPolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeServerExtendedAuditingPolicyID(input string) (string, error) {
+	id, err := ServerExtendedAuditingPolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
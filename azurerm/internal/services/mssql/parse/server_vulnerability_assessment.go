@@ -73,3 +73,55 @@ func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessme
 
 	return &resourceId, nil
 }
+
+// ServerVulnerabilityAssessmentIDInsensitively parses an ServerVulnerabilityAssessment ID into an ServerVulnerabilityAssessmentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ServerVulnerabilityAssessmentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ServerVulnerabilityAssessmentIDInsensitively(input string) (*ServerVulnerabilityAssessmentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ServerVulnerabilityAssessmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
+		return nil, err
+	}
+	if resourceId.VulnerabilityAssessmentName, err = id.PopSegmentInsensitively("vulnerabilityAssessments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeServerVulnerabilityAssessmentID parses the specified ServerVulnerabilityAssessment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeServerVulnerabilityAssessmentID(input string) (string, error) {
+	id, err := ServerVulnerabilityAssessmentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
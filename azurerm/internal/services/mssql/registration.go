@@ -18,12 +18,21 @@ func (r Registration) WebsiteCategories() []string {
 	}
 }
 
+// ResourceProviders returns a list of the Resource Providers used by this Service
+func (r Registration) ResourceProviders() []string {
+	return []string{
+		"Microsoft.Sql",
+		"Microsoft.SqlVirtualMachine",
+	}
+}
+
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"azurerm_mssql_database":    dataSourceMsSqlDatabase(),
-		"azurerm_mssql_elasticpool": dataSourceMsSqlElasticpool(),
-		"azurerm_mssql_server":      dataSourceMsSqlServer(),
+		"azurerm_mssql_database":         dataSourceMsSqlDatabase(),
+		"azurerm_mssql_elasticpool":      dataSourceMsSqlElasticpool(),
+		"azurerm_mssql_managed_instance": dataSourceMsSqlManagedInstance(),
+		"azurerm_mssql_server":           dataSourceMsSqlServer(),
 	}
 }
 
@@ -31,12 +40,24 @@ func (r Registration) SupportedDataSources() map[string]*schema.Resource {
 func (r Registration) SupportedResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"azurerm_mssql_database":                                        resourceMsSqlDatabase(),
+		"azurerm_mssql_database_data_masking_policy":                    resourceMsSqlDatabaseDataMaskingPolicy(),
 		"azurerm_mssql_database_extended_auditing_policy":               resourceMsSqlDatabaseExtendedAuditingPolicy(),
+		"azurerm_mssql_database_sensitivity_label":                      resourceMsSqlDatabaseSensitivityLabel(),
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
+		"azurerm_mssql_job":                                             resourceMsSqlJob(),
+		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
+		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
+		"azurerm_mssql_job_step":                                        resourceMsSqlJobStep(),
+		"azurerm_mssql_job_target_group":                                resourceMsSqlJobTargetGroup(),
+		"azurerm_mssql_managed_database":                                resourceMsSqlManagedDatabase(),
+		"azurerm_mssql_managed_instance":                                resourceMsSqlManagedInstance(),
+		"azurerm_mssql_managed_instance_active_directory_administrator": resourceMsSqlManagedInstanceActiveDirectoryAdministrator(),
+		"azurerm_mssql_managed_instance_failover_group":                 resourceMsSqlManagedInstanceFailoverGroup(),
 		"azurerm_mssql_server":                                          resourceMsSqlServer(),
 		"azurerm_mssql_server_extended_auditing_policy":                 resourceMsSqlServerExtendedAuditingPolicy(),
 		"azurerm_mssql_server_security_alert_policy":                    resourceMsSqlServerSecurityAlertPolicy(),
+		"azurerm_mssql_server_transparent_data_encryption":              resourceMsSqlServerTransparentDataEncryption(),
 		"azurerm_mssql_server_vulnerability_assessment":                 resourceMsSqlServerVulnerabilityAssessment(),
 		"azurerm_mssql_virtual_machine":                                 resourceMsSqlVirtualMachine(),
 	}
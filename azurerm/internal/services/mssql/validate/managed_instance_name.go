@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ManagedInstanceName validates the name of a SQL Managed Instance, which can contain only lowercase letters,
+// numbers and '-', but can't start or end with '-' or have more than 63 characters.
+func ManagedInstanceName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if !regexp.MustCompile(`^[0-9a-z]([-0-9a-z]{0,61}[0-9a-z])?$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q can contain only lowercase letters, numbers and '-', but can't start or end with '-' or have more than 63 characters", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestManagedInstanceName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			valid: false,
+		},
+		{
+			name:  "Single Character",
+			input: "a",
+			valid: true,
+		},
+		{
+			name:  "Lowercase letters, numbers and hyphens",
+			input: "example-instance-01",
+			valid: true,
+		},
+		{
+			name:  "Uppercase letters",
+			input: "Example-Instance",
+			valid: false,
+		},
+		{
+			name:  "Starts with a hyphen",
+			input: "-example",
+			valid: false,
+		},
+		{
+			name:  "Ends with a hyphen",
+			input: "example-",
+			valid: false,
+		},
+		{
+			name:  "Underscore",
+			input: "example_instance",
+			valid: false,
+		},
+		{
+			name:  "63 characters",
+			input: strings.Repeat("a", 63),
+			valid: true,
+		},
+		{
+			name:  "64 characters",
+			input: strings.Repeat("a", 64),
+			valid: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ManagedInstanceName(tt.input, "name")
+			valid := len(err) == 0
+			if valid != tt.valid {
+				t.Errorf("Expected valid status %t but got %t for input %s", tt.valid, valid, tt.input)
+			}
+		})
+	}
+}
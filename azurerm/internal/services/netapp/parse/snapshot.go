@@ -85,3 +85,61 @@ func SnapshotID(input string) (*SnapshotId, error) {
 
 	return &resourceId, nil
 }
+
+// SnapshotIDInsensitively parses an Snapshot ID into an SnapshotId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SnapshotID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SnapshotIDInsensitively(input string) (*SnapshotId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SnapshotId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetAppAccountName, err = id.PopSegmentInsensitively("netAppAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.CapacityPoolName, err = id.PopSegmentInsensitively("capacityPools"); err != nil {
+		return nil, err
+	}
+	if resourceId.VolumeName, err = id.PopSegmentInsensitively("volumes"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("snapshots"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSnapshotID parses the specified Snapshot ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSnapshotID(input string) (string, error) {
+	id, err := SnapshotIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -224,7 +224,7 @@ func resourceNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
 		parameters.NatGatewayPropertiesFormat.PublicIPPrefixes = expandNetworkSubResourceID(publicIpPrefixIds)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 		parameters.Tags = tags.Expand(t)
 	}
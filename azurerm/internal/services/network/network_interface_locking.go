@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -12,14 +14,26 @@ type networkInterfaceIPConfigurationLockingDetails struct {
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+// keys returns the keys for the Subnets and Virtual Networks which need to be locked - since the
+// Network Interface only references (rather than modifies) these, they're locked in shared mode
+// so that multiple Network Interfaces within the same Subnet can be provisioned in parallel
+func (details networkInterfaceIPConfigurationLockingDetails) keys() []string {
+	keys := make([]string, 0)
+	for _, name := range details.subnetNamesToLock {
+		keys = append(keys, locks.NameKey(name, SubnetResourceName))
+	}
+	for _, name := range details.virtualNetworkNamesToLock {
+		keys = append(keys, locks.NameKey(name, VirtualNetworkResourceName))
+	}
+	return keys
+}
+
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context) error {
+	return locks.MultipleMixedWithContext(ctx, []string{}, details.keys())
 }
 
 func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+	locks.UnlockMultipleMixed([]string{}, details.keys())
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
@@ -78,8 +78,8 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *schema.ResourceDa
 	}
 	nsgName := nsgId.Path["networkSecurityGroups"]
 
-	locks.ByName(nsgName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	locks.ByNameShared(nsgName, networkSecurityGroupResourceName)
+	defer locks.UnlockByNameShared(nsgName, networkSecurityGroupResourceName)
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -243,7 +243,9 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -348,7 +350,9 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		if err := lockingDetails.lock(ctx); err != nil {
+			return err
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -359,7 +363,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 		update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		update.Tags = tags.Expand(tagsRaw)
 	} else {
@@ -513,7 +517,9 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return err
+	}
 	defer lockingDetails.unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, name)
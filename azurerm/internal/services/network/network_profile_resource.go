@@ -128,11 +128,11 @@ func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	subnetLockKeys, vnetLockKeys := networkProfileLockKeys(subnetsToLock, vnetsToLock)
+	if err := locks.MultipleMixedWithContext(ctx, subnetLockKeys, vnetLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(subnetLockKeys, vnetLockKeys)
 
 	parameters := network.Profile{
 		Location: &location,
@@ -235,11 +235,11 @@ func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	subnetLockKeys, vnetLockKeys := networkProfileLockKeys(subnetsToLock, vnetsToLock)
+	if err := locks.MultipleMixedWithContext(ctx, subnetLockKeys, vnetLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(subnetLockKeys, vnetLockKeys)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -374,3 +374,20 @@ func flattenNetworkProfileContainerNetworkInterfaceIDs(input *[]network.Containe
 
 	return retCNIs
 }
+
+// networkProfileLockKeys returns the keys for the Subnets (which are locked exclusively) and the Virtual
+// Networks (which are only read, so are locked in shared mode) - which must be locked in a single call
+// so that they're acquired in the same order as the Subnet Association resources
+func networkProfileLockKeys(subnetNames *[]string, vnetNames *[]string) ([]string, []string) {
+	subnetKeys := make([]string, 0)
+	for _, name := range *subnetNames {
+		subnetKeys = append(subnetKeys, locks.NameKey(name, SubnetResourceName))
+	}
+
+	vnetKeys := make([]string, 0)
+	for _, name := range *vnetNames {
+		vnetKeys = append(vnetKeys, locks.NameKey(name, VirtualNetworkResourceName))
+	}
+
+	return subnetKeys, vnetKeys
+}
@@ -73,3 +73,55 @@ func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
 
 	return &resourceId, nil
 }
+
+// ConnectionMonitorIDInsensitively parses an ConnectionMonitor ID into an ConnectionMonitorId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ConnectionMonitorID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ConnectionMonitorIDInsensitively(input string) (*ConnectionMonitorId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ConnectionMonitorId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NetworkWatcherName, err = id.PopSegmentInsensitively("networkWatchers"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("connectionMonitors"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeConnectionMonitorID parses the specified ConnectionMonitor ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeConnectionMonitorID(input string) (string, error) {
+	id, err := ConnectionMonitorIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
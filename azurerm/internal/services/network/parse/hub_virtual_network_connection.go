@@ -73,3 +73,55 @@ func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId
 
 	return &resourceId, nil
 }
+
+// HubVirtualNetworkConnectionIDInsensitively parses an HubVirtualNetworkConnection ID into an HubVirtualNetworkConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the HubVirtualNetworkConnectionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func HubVirtualNetworkConnectionIDInsensitively(input string) (*HubVirtualNetworkConnectionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := HubVirtualNetworkConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("hubVirtualNetworkConnections"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeHubVirtualNetworkConnectionID parses the specified HubVirtualNetworkConnection ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeHubVirtualNetworkConnectionID(input string) (string, error) {
+	id, err := HubVirtualNetworkConnectionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
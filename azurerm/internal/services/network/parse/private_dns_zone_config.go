@@ -79,3 +79,58 @@ func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneConfigIDInsensitively parses an PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct, insensitively
+// This should only be used to parse an ID for rewriting, the PrivateDnsZoneConfigID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func PrivateDnsZoneConfigIDInsensitively(input string) (*PrivateDnsZoneConfigId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsZoneConfigId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.PrivateEndpointName, err = id.PopSegmentInsensitively("privateEndpoints"); err != nil {
+		return nil, err
+	}
+	if resourceId.PrivateDnsZoneGroupName, err = id.PopSegmentInsensitively("privateDnsZoneGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZoneConfigs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizePrivateDnsZoneConfigID parses the specified PrivateDnsZoneConfig ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizePrivateDnsZoneConfigID(input string) (string, error) {
+	id, err := PrivateDnsZoneConfigIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
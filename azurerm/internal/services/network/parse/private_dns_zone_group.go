@@ -73,3 +73,55 @@ func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneGroupIDInsensitively parses an PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the PrivateDnsZoneGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func PrivateDnsZoneGroupIDInsensitively(input string) (*PrivateDnsZoneGroupId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsZoneGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.PrivateEndpointName, err = id.PopSegmentInsensitively("privateEndpoints"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZoneGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizePrivateDnsZoneGroupID parses the specified PrivateDnsZoneGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizePrivateDnsZoneGroupID(input string) (string, error) {
+	id, err := PrivateDnsZoneGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
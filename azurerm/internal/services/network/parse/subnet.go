@@ -81,7 +81,7 @@ func SubnetID(input string) (*SubnetId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func SubnetIDInsensitively(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -99,27 +99,10 @@ func SubnetIDInsensitively(input string) (*SubnetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'virtualNetworks' segment
-	virtualNetworksKey := "virtualNetworks"
-	for key := range id.Path {
-		if strings.EqualFold(key, virtualNetworksKey) {
-			virtualNetworksKey = key
-			break
-		}
-	}
-	if resourceId.VirtualNetworkName, err = id.PopSegment(virtualNetworksKey); err != nil {
+	if resourceId.VirtualNetworkName, err = id.PopSegmentInsensitively("virtualNetworks"); err != nil {
 		return nil, err
 	}
-
-	// find the correct casing for the 'subnets' segment
-	subnetsKey := "subnets"
-	for key := range id.Path {
-		if strings.EqualFold(key, subnetsKey) {
-			subnetsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(subnetsKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("subnets"); err != nil {
 		return nil, err
 	}
 
@@ -129,3 +112,16 @@ func SubnetIDInsensitively(input string) (*SubnetId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeSubnetID parses the specified Subnet ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSubnetID(input string) (string, error) {
+	id, err := SubnetIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
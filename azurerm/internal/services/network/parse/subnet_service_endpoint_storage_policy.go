@@ -67,3 +67,52 @@ func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointS
 
 	return &resourceId, nil
 }
+
+// SubnetServiceEndpointStoragePolicyIDInsensitively parses an SubnetServiceEndpointStoragePolicy ID into an SubnetServiceEndpointStoragePolicyId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SubnetServiceEndpointStoragePolicyID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SubnetServiceEndpointStoragePolicyIDInsensitively(input string) (*SubnetServiceEndpointStoragePolicyId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubnetServiceEndpointStoragePolicyId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceEndpointPolicyName, err = id.PopSegmentInsensitively("serviceEndpointPolicies"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSubnetServiceEndpointStoragePolicyID parses the specified SubnetServiceEndpointStoragePolicy ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSubnetServiceEndpointStoragePolicyID(input string) (string, error) {
+	id, err := SubnetServiceEndpointStoragePolicyIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
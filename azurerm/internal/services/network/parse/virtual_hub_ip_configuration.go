@@ -73,3 +73,55 @@ func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, er
 
 	return &resourceId, nil
 }
+
+// VirtualHubIpConfigurationIDInsensitively parses an VirtualHubIpConfiguration ID into an VirtualHubIpConfigurationId struct, insensitively
+// This should only be used to parse an ID for rewriting, the VirtualHubIpConfigurationID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func VirtualHubIpConfigurationIDInsensitively(input string) (*VirtualHubIpConfigurationId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VirtualHubIpConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.IpConfigurationName, err = id.PopSegmentInsensitively("ipConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeVirtualHubIpConfigurationID parses the specified VirtualHubIpConfiguration ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeVirtualHubIpConfigurationID(input string) (string, error) {
+	id, err := VirtualHubIpConfigurationIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
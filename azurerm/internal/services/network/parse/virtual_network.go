@@ -75,7 +75,7 @@ func VirtualNetworkID(input string) (*VirtualNetworkId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func VirtualNetworkIDInsensitively(input string) (*VirtualNetworkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +93,7 @@ func VirtualNetworkIDInsensitively(input string) (*VirtualNetworkId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	// find the correct casing for the 'virtualNetworks' segment
-	virtualNetworksKey := "virtualNetworks"
-	for key := range id.Path {
-		if strings.EqualFold(key, virtualNetworksKey) {
-			virtualNetworksKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(virtualNetworksKey); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualNetworks"); err != nil {
 		return nil, err
 	}
 
@@ -111,3 +103,16 @@ func VirtualNetworkIDInsensitively(input string) (*VirtualNetworkId, error) {
 
 	return &resourceId, nil
 }
+
+// NormalizeVirtualNetworkID parses the specified VirtualNetwork ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeVirtualNetworkID(input string) (string, error) {
+	id, err := VirtualNetworkIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
 
 	return &resourceId, nil
 }
+
+// VpnSiteLinkIDInsensitively parses an VpnSiteLink ID into an VpnSiteLinkId struct, insensitively
+// This should only be used to parse an ID for rewriting, the VpnSiteLinkID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func VpnSiteLinkIDInsensitively(input string) (*VpnSiteLinkId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := VpnSiteLinkId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.VpnSiteName, err = id.PopSegmentInsensitively("vpnSites"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnSiteLinks"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeVpnSiteLinkID parses the specified VpnSiteLink ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeVpnSiteLinkID(input string) (string, error) {
+	id, err := VpnSiteLinkIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,12 +73,18 @@ func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta inte
 
 	gatewayName := parsedGatewayId.Name
 
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	// the NAT Gateway is only read, whereas the Subnet (and so the Virtual Network) is written to
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(gatewayName, natGatewayResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -196,10 +202,18 @@ func resourceSubnetNatGatewayAssociationDelete(d *schema.ResourceData, meta inte
 	}
 
 	gatewayName := parsedGatewayId.Path["natGateways"]
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(gatewayName, natGatewayResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -70,18 +70,22 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
 	subnetName := parsedSubnetId.Path["subnets"]
 	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	// writing to the Subnet is a write to its Virtual Network, so both are locked exclusively - whereas the Network Security Group is only read
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -205,14 +209,17 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData,
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -414,11 +414,14 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	name := id.Path["subnets"]
 	networkName := id.Path["virtualNetworks"]
 
-	locks.ByName(networkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(networkName, VirtualNetworkResourceName)
-
-	locks.ByName(name, SubnetResourceName)
-	defer locks.UnlockByName(name, SubnetResourceName)
+	lockKeys := []string{
+		locks.NameKey(networkName, VirtualNetworkResourceName),
+		locks.NameKey(name, SubnetResourceName),
+	}
+	if err := locks.MultipleWithContext(ctx, lockKeys...); err != nil {
+		return err
+	}
+	defer locks.UnlockMultiple(lockKeys...)
 
 	future, err := client.Delete(ctx, resourceGroup, networkName, name)
 	if err != nil {
@@ -70,15 +70,22 @@ func resourceSubnetRouteTableAssociationCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	// the Route Table is only read, but updating the Subnet updates the Virtual Network - so both of those are locked exclusively
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -202,11 +209,17 @@ func resourceSubnetRouteTableAssociationDelete(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	lockKeys := []string{
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	}
+	sharedLockKeys := []string{
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+	}
+	if err := locks.MultipleMixedWithContext(ctx, lockKeys, sharedLockKeys); err != nil {
+		return err
+	}
+	defer locks.UnlockMultipleMixed(lockKeys, sharedLockKeys)
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
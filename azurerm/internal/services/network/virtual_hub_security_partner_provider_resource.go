@@ -176,7 +176,7 @@ func resourceVirtualHubSecurityPartnerProviderUpdate(d *schema.ResourceData, met
 
 	parameters := network.TagsObject{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -79,3 +79,58 @@ func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthoriza
 
 	return &resourceId, nil
 }
+
+// NotificationHubAuthorizationRuleIDInsensitively parses an NotificationHubAuthorizationRule ID into an NotificationHubAuthorizationRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NotificationHubAuthorizationRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NotificationHubAuthorizationRuleIDInsensitively(input string) (*NotificationHubAuthorizationRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NotificationHubAuthorizationRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.NotificationHubName, err = id.PopSegmentInsensitively("notificationHubs"); err != nil {
+		return nil, err
+	}
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("AuthorizationRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeNotificationHubAuthorizationRuleID parses the specified NotificationHubAuthorizationRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeNotificationHubAuthorizationRuleID(input string) (string, error) {
+	id, err := NotificationHubAuthorizationRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
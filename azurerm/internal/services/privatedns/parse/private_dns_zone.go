@@ -67,3 +67,52 @@ func PrivateDnsZoneID(input string) (*PrivateDnsZoneId, error) {
 
 	return &resourceId, nil
 }
+
+// PrivateDnsZoneIDInsensitively parses an PrivateDnsZone ID into an PrivateDnsZoneId struct, insensitively
+// This should only be used to parse an ID for rewriting, the PrivateDnsZoneID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func PrivateDnsZoneIDInsensitively(input string) (*PrivateDnsZoneId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PrivateDnsZoneId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZones"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizePrivateDnsZoneID parses the specified PrivateDnsZone ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizePrivateDnsZoneID(input string) (string, error) {
+	id, err := PrivateDnsZoneIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
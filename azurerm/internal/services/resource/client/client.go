@@ -13,6 +13,7 @@ type Client struct {
 	LocksClient       *locks.ManagementLocksClient
 	ProvidersClient   *providers.ProvidersClient
 	ResourcesClient   *resources.Client
+	TagsClient        *resources.TagsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -32,11 +33,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	resourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&resourcesClient.Client, o.ResourceManagerAuthorizer)
 
+	tagsClient := resources.NewTagsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&tagsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		GroupsClient:      &groupsClient,
 		DeploymentsClient: &deploymentsClient,
 		LocksClient:       &locksClient,
 		ProvidersClient:   &providersClient,
 		ResourcesClient:   &resourcesClient,
+		TagsClient:        &tagsClient,
 	}
 }
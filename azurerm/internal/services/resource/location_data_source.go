@@ -0,0 +1,88 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceLocation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLocationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"catalog_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"paired_region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLocationRead(d *schema.ResourceData, meta interface{}) error {
+	account := meta.(*clients.Client).Account
+	_, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	catalog := location.CatalogForEnvironment(account.Environment)
+	if catalog == nil {
+		return fmt.Errorf("the Location Catalog is not available for the Azure Environment %q", account.Environment.Name)
+	}
+
+	input := d.Get("location").(string)
+	loc := catalog.Get(input)
+	if loc == nil {
+		return fmt.Errorf("Location %q was not found in version %q of the Location Catalog for %q", input, catalog.Version, catalog.Environment)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/locations/%s", account.SubscriptionId, loc.Name))
+
+	d.Set("catalog_version", catalog.Version)
+	d.Set("display_name", loc.DisplayName)
+	d.Set("name", loc.Name)
+	d.Set("paired_region_name", loc.PairedRegion)
+
+	if err := d.Set("zones", loc.Zones); err != nil {
+		return fmt.Errorf("setting `zones`: %+v", err)
+	}
+
+	return nil
+}
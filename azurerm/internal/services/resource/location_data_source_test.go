@@ -0,0 +1,60 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type LocationDataSource struct {
+}
+
+func TestAccDataSourceLocation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_location", "test")
+	r := LocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic("West Europe"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("westeurope"),
+				check.That(data.ResourceName).Key("display_name").HasValue("West Europe"),
+				check.That(data.ResourceName).Key("paired_region_name").HasValue("northeurope"),
+				check.That(data.ResourceName).Key("zones.#").HasValue("3"),
+				check.That(data.ResourceName).Key("catalog_version").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceLocation_withoutZones(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_location", "test")
+	r := LocationDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: r.basic("westcentralus"),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("name").HasValue("westcentralus"),
+				check.That(data.ResourceName).Key("display_name").HasValue("West Central US"),
+				check.That(data.ResourceName).Key("paired_region_name").HasValue("westus2"),
+				check.That(data.ResourceName).Key("zones.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (LocationDataSource) basic(location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_location" "test" {
+  location = %q
+}
+`, location)
+}
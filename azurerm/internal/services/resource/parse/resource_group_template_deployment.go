@@ -67,3 +67,52 @@ func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDepl
 
 	return &resourceId, nil
 }
+
+// ResourceGroupTemplateDeploymentIDInsensitively parses an ResourceGroupTemplateDeployment ID into an ResourceGroupTemplateDeploymentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ResourceGroupTemplateDeploymentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ResourceGroupTemplateDeploymentIDInsensitively(input string) (*ResourceGroupTemplateDeploymentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ResourceGroupTemplateDeploymentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DeploymentName, err = id.PopSegmentInsensitively("deployments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeResourceGroupTemplateDeploymentID parses the specified ResourceGroupTemplateDeployment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeResourceGroupTemplateDeploymentID(input string) (string, error) {
+	id, err := ResourceGroupTemplateDeploymentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -59,3 +59,47 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 
 	return &resourceId, nil
 }
+
+// SubscriptionTemplateDeploymentIDInsensitively parses an SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SubscriptionTemplateDeploymentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SubscriptionTemplateDeploymentIDInsensitively(input string) (*SubscriptionTemplateDeploymentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubscriptionTemplateDeploymentId{
+		SubscriptionId: id.SubscriptionID,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.DeploymentName, err = id.PopSegmentInsensitively("deployments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSubscriptionTemplateDeploymentID parses the specified SubscriptionTemplateDeployment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSubscriptionTemplateDeploymentID(input string) (string, error) {
+	id, err := SubscriptionTemplateDeploymentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -3,6 +3,7 @@ package resource_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -30,6 +32,37 @@ func TestAccResourceGroup_basic(t *testing.T) {
 	})
 }
 
+// TestAccResourceGroup_recorded replays the requests recorded within `testdata/recordings` unless
+// `ARM_TEST_RECORDING_MODE` is set, so that this runs without connecting to Azure - these requests
+// can be re-recorded by setting `ARM_TEST_RECORDING_MODE` to `record`
+func TestAccResourceGroup_recorded(t *testing.T) {
+	if !testing.Verbose() {
+		t.Skip("the Plugin SDK requires that Acceptance Tests are run with the -v flag")
+	}
+	if os.Getenv(recording.ModeEnvironmentVariable) == "" {
+		os.Setenv(recording.ModeEnvironmentVariable, string(recording.ModeReplay))
+		defer os.Unsetenv(recording.ModeEnvironmentVariable)
+	}
+	if os.Getenv(resource.TestEnvVar) == "" {
+		os.Setenv(resource.TestEnvVar, "1")
+		defer os.Unsetenv(resource.TestEnvVar)
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	testResource := ResourceGroupResource{}
+	data.ResourceTest(t, testResource, []resource.TestStep{
+		{
+			Config: testResource.withTagsConfig(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(testResource),
+				check.That(data.ResourceName).Key("tags.%").HasValue("2"),
+				check.That(data.ResourceName).Key("tags.environment").HasValue("Production"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccResourceGroup_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
 	testResource := ResourceGroupResource{}
@@ -215,7 +215,7 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta
 		deployment.Properties.Template = exportedTemplate.Template
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -233,8 +233,8 @@ func (r ResourceProviderRegistrationResource) checkIfManagedByTerraform(name str
 			fmtStr := `The Resource Provider %q is automatically registered by Terraform.
 
 To manage this Resource Provider Registration with Terraform you need to opt-out
-of Automatic Resource Provider Registration (by setting 'skip_provider_registration'
-to 'true' in the Provider block) to avoid conflicting with Terraform.`
+of Automatic Resource Provider Registration (by setting 'resource_provider_registration_mode'
+to 'none' in the Provider block) to avoid conflicting with Terraform.`
 			return fmt.Errorf(fmtStr, name)
 		}
 	}
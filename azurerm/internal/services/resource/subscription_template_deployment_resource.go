@@ -203,7 +203,7 @@ func subscriptionTemplateDeploymentResourceUpdate(d *schema.ResourceData, meta i
 		deployment.Properties.Template = exportedTemplate.Template
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		deployment.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -73,3 +73,55 @@ func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error)
 
 	return &resourceId, nil
 }
+
+// NamespaceNetworkRuleSetIDInsensitively parses an NamespaceNetworkRuleSet ID into an NamespaceNetworkRuleSetId struct, insensitively
+// This should only be used to parse an ID for rewriting, the NamespaceNetworkRuleSetID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func NamespaceNetworkRuleSetIDInsensitively(input string) (*NamespaceNetworkRuleSetId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NamespaceNetworkRuleSetId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.NetworkrulesetName, err = id.PopSegmentInsensitively("networkrulesets"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeNamespaceNetworkRuleSetID parses the specified NamespaceNetworkRuleSet ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeNamespaceNetworkRuleSetID(input string) (string, error) {
+	id, err := NamespaceNetworkRuleSetIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
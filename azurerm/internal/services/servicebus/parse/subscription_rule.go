@@ -85,3 +85,61 @@ func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// SubscriptionRuleIDInsensitively parses an SubscriptionRule ID into an SubscriptionRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SubscriptionRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SubscriptionRuleIDInsensitively(input string) (*SubscriptionRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SubscriptionRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
+		return nil, err
+	}
+	if resourceId.SubscriptionName, err = id.PopSegmentInsensitively("subscriptions"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleName, err = id.PopSegmentInsensitively("rules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSubscriptionRuleID parses the specified SubscriptionRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSubscriptionRuleID(input string) (string, error) {
+	id, err := SubscriptionRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
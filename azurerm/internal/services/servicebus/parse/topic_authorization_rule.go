@@ -79,3 +79,58 @@ func TopicAuthorizationRuleID(input string) (*TopicAuthorizationRuleId, error) {
 
 	return &resourceId, nil
 }
+
+// TopicAuthorizationRuleIDInsensitively parses an TopicAuthorizationRule ID into an TopicAuthorizationRuleId struct, insensitively
+// This should only be used to parse an ID for rewriting, the TopicAuthorizationRuleID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func TopicAuthorizationRuleIDInsensitively(input string) (*TopicAuthorizationRuleId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := TopicAuthorizationRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
+		return nil, err
+	}
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("authorizationRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeTopicAuthorizationRuleID parses the specified TopicAuthorizationRule ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeTopicAuthorizationRuleID(input string) (string, error) {
+	id, err := TopicAuthorizationRuleIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
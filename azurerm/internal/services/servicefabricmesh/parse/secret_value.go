@@ -73,3 +73,55 @@ func SecretValueID(input string) (*SecretValueId, error) {
 
 	return &resourceId, nil
 }
+
+// SecretValueIDInsensitively parses an SecretValue ID into an SecretValueId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SecretValueID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SecretValueIDInsensitively(input string) (*SecretValueId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SecretValueId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SecretName, err = id.PopSegmentInsensitively("secrets"); err != nil {
+		return nil, err
+	}
+	if resourceId.ValueName, err = id.PopSegmentInsensitively("values"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSecretValueID parses the specified SecretValue ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSecretValueID(input string) (string, error) {
+	id, err := SecretValueIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -312,7 +312,7 @@ func resourceArmSignalRServiceUpdate(d *schema.ResourceData, meta interface{}) e
 		parameters.Sku = expandSignalRServiceSku(sku)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
 		parameters.Tags = tags.Expand(tagsRaw)
 	}
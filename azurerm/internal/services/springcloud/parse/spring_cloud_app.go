@@ -73,3 +73,55 @@ func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudAppIDInsensitively parses an SpringCloudApp ID into an SpringCloudAppId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SpringCloudAppID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SpringCloudAppIDInsensitively(input string) (*SpringCloudAppId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SpringCloudAppId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
+		return nil, err
+	}
+	if resourceId.AppName, err = id.PopSegmentInsensitively("apps"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSpringCloudAppID parses the specified SpringCloudApp ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSpringCloudAppID(input string) (string, error) {
+	id, err := SpringCloudAppIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
 
 	return &resourceId, nil
 }
+
+// SpringCloudCertificateIDInsensitively parses an SpringCloudCertificate ID into an SpringCloudCertificateId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SpringCloudCertificateID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SpringCloudCertificateIDInsensitively(input string) (*SpringCloudCertificateId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SpringCloudCertificateId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
+		return nil, err
+	}
+	if resourceId.CertificateName, err = id.PopSegmentInsensitively("certificates"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSpringCloudCertificateID parses the specified SpringCloudCertificate ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSpringCloudCertificateID(input string) (string, error) {
+	id, err := SpringCloudCertificateIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -307,7 +307,7 @@ func resourceSpringCloudServiceUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		model := appplatform.ServiceResource{
 			Sku: &appplatform.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
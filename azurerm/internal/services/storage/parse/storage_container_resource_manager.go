@@ -79,3 +79,58 @@ func StorageContainerResourceManagerID(input string) (*StorageContainerResourceM
 
 	return &resourceId, nil
 }
+
+// StorageContainerResourceManagerIDInsensitively parses an StorageContainerResourceManager ID into an StorageContainerResourceManagerId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StorageContainerResourceManagerID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StorageContainerResourceManagerIDInsensitively(input string) (*StorageContainerResourceManagerId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageContainerResourceManagerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.BlobServiceName, err = id.PopSegmentInsensitively("blobServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStorageContainerResourceManagerID parses the specified StorageContainerResourceManager ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStorageContainerResourceManagerID(input string) (string, error) {
+	id, err := StorageContainerResourceManagerIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
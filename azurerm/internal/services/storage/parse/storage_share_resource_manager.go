@@ -79,3 +79,58 @@ func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId
 
 	return &resourceId, nil
 }
+
+// StorageShareResourceManagerIDInsensitively parses an StorageShareResourceManager ID into an StorageShareResourceManagerId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StorageShareResourceManagerID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StorageShareResourceManagerIDInsensitively(input string) (*StorageShareResourceManagerId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageShareResourceManagerId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.FileServiceName, err = id.PopSegmentInsensitively("fileServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ShareName, err = id.PopSegmentInsensitively("shares"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStorageShareResourceManagerID parses the specified StorageShareResourceManager ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStorageShareResourceManagerID(input string) (string, error) {
+	id, err := StorageShareResourceManagerIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
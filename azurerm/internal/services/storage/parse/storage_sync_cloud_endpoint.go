@@ -79,3 +79,58 @@ func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, erro
 
 	return &resourceId, nil
 }
+
+// StorageSyncCloudEndpointIDInsensitively parses an StorageSyncCloudEndpoint ID into an StorageSyncCloudEndpointId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StorageSyncCloudEndpointID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StorageSyncCloudEndpointIDInsensitively(input string) (*StorageSyncCloudEndpointId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageSyncCloudEndpointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageSyncServiceName, err = id.PopSegmentInsensitively("storageSyncServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.SyncGroupName, err = id.PopSegmentInsensitively("syncGroups"); err != nil {
+		return nil, err
+	}
+	if resourceId.CloudEndpointName, err = id.PopSegmentInsensitively("cloudEndpoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStorageSyncCloudEndpointID parses the specified StorageSyncCloudEndpoint ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStorageSyncCloudEndpointID(input string) (string, error) {
+	id, err := StorageSyncCloudEndpointIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -73,3 +73,55 @@ func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
 
 	return &resourceId, nil
 }
+
+// StorageSyncGroupIDInsensitively parses an StorageSyncGroup ID into an StorageSyncGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StorageSyncGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StorageSyncGroupIDInsensitively(input string) (*StorageSyncGroupId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StorageSyncGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageSyncServiceName, err = id.PopSegmentInsensitively("storageSyncServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.SyncGroupName, err = id.PopSegmentInsensitively("syncGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStorageSyncGroupID parses the specified StorageSyncGroup ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStorageSyncGroupID(input string) (string, error) {
+	id, err := StorageSyncGroupIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -153,7 +153,7 @@ func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	update := storagesync.ServiceUpdateParameters{}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
@@ -73,3 +73,55 @@ func StreamInputID(input string) (*StreamInputId, error) {
 
 	return &resourceId, nil
 }
+
+// StreamInputIDInsensitively parses an StreamInput ID into an StreamInputId struct, insensitively
+// This should only be used to parse an ID for rewriting, the StreamInputID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func StreamInputIDInsensitively(input string) (*StreamInputId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := StreamInputId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StreamingjobName, err = id.PopSegmentInsensitively("streamingjobs"); err != nil {
+		return nil, err
+	}
+	if resourceId.InputName, err = id.PopSegmentInsensitively("inputs"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeStreamInputID parses the specified StreamInput ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeStreamInputID(input string) (string, error) {
+	id, err := StreamInputIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
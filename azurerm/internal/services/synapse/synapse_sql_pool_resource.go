@@ -282,7 +282,7 @@ func resourceSynapseSqlPoolUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChanges("sku_name", "tags") {
+	if d.HasChanges("sku_name", "tags", "tags_all") {
 		sqlPoolInfo := synapse.SQLPoolPatchInfo{
 			Sku: &synapse.Sku{
 				Name: utils.String(d.Get("sku_name").(string)),
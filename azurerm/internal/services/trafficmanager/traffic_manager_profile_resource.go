@@ -305,7 +305,7 @@ func resourceArmTrafficManagerProfileUpdate(d *schema.ResourceData, meta interfa
 	update := trafficmanager.Profile{
 		ProfileProperties: &trafficmanager.ProfileProperties{},
 	}
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
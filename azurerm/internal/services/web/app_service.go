@@ -9,7 +9,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2020-06-01/web"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/identity"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -1473,63 +1473,76 @@ func expandAppServiceLogs(input interface{}) web.SiteLogsConfigProperties {
 	return logs
 }
 
-func expandAppServiceIdentity(input []interface{}) *web.ManagedServiceIdentity {
-	if len(input) == 0 {
-		return nil
+// appServiceManagedServiceIdentity is used to expand and flatten the `identity` block - however unlike other
+// resources `type` can be explicitly set to `None`, which the identity helpers treat as the absence of the block
+type appServiceManagedServiceIdentity = identity.SystemAssignedUserAssigned
+
+func expandAppServiceIdentity(input []interface{}) (*web.ManagedServiceIdentity, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
 	}
-	identity := input[0].(map[string]interface{})
-	identityType := web.ManagedServiceIdentityType(identity["type"].(string))
 
-	identityIds := make(map[string]*web.ManagedServiceIdentityUserAssignedIdentitiesValue)
-	for _, id := range identity["identity_ids"].([]interface{}) {
-		identityIds[id.(string)] = &web.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+	raw := input[0].(map[string]interface{})
+	config, err := appServiceManagedServiceIdentity{}.ExpandModel([]identity.ModelSystemAssignedUserAssigned{
+		{
+			Type:        raw["type"].(string),
+			IdentityIds: *utils.ExpandStringSlice(raw["identity_ids"].([]interface{})),
+		},
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	managedServiceIdentity := web.ManagedServiceIdentity{
-		Type: identityType,
+	output := web.ManagedServiceIdentity{
+		Type: web.ManagedServiceIdentityType(config.Type),
 	}
 
-	if managedServiceIdentity.Type == web.ManagedServiceIdentityTypeUserAssigned || managedServiceIdentity.Type == web.ManagedServiceIdentityTypeSystemAssignedUserAssigned {
-		managedServiceIdentity.UserAssignedIdentities = identityIds
+	if config.UserAssignedIdentityIds != nil {
+		identityIds := make(map[string]*web.ManagedServiceIdentityUserAssignedIdentitiesValue)
+		for _, id := range *config.UserAssignedIdentityIds {
+			identityIds[id] = &web.ManagedServiceIdentityUserAssignedIdentitiesValue{}
+		}
+		output.UserAssignedIdentities = identityIds
 	}
 
-	return &managedServiceIdentity
+	return &output, nil
 }
 
-func flattenAppServiceIdentity(identity *web.ManagedServiceIdentity) ([]interface{}, error) {
-	if identity == nil {
+func flattenAppServiceIdentity(input *web.ManagedServiceIdentity) ([]interface{}, error) {
+	if input == nil {
 		return make([]interface{}, 0), nil
 	}
 
-	principalId := ""
-	if identity.PrincipalID != nil {
-		principalId = *identity.PrincipalID
+	identityIds := make([]string, 0)
+	for key := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, key)
 	}
 
-	tenantId := ""
-	if identity.TenantID != nil {
-		tenantId = *identity.TenantID
+	config := &identity.ExpandedConfig{
+		Type:                    string(input.Type),
+		PrincipalId:             input.PrincipalID,
+		TenantId:                input.TenantID,
+		UserAssignedIdentityIds: &identityIds,
 	}
 
-	identityIds := make([]string, 0)
-	if identity.UserAssignedIdentities != nil {
-		for key := range identity.UserAssignedIdentities {
-			parsedId, err := parse.UserAssignedIdentityID(key)
-			if err != nil {
-				return nil, err
-			}
-			identityIds = append(identityIds, parsedId.ID())
-		}
+	models := appServiceManagedServiceIdentity{}.FlattenModel(config)
+	if len(models) == 0 {
+		models = append(models, identity.ModelSystemAssignedUserAssigned{
+			Type:        string(web.ManagedServiceIdentityTypeNone),
+			IdentityIds: []string{},
+		})
 	}
 
-	return []interface{}{
-		map[string]interface{}{
-			"identity_ids": identityIds,
-			"principal_id": principalId,
-			"tenant_id":    tenantId,
-			"type":         string(identity.Type),
-		},
-	}, nil
+	output := make([]interface{}, 0)
+	for _, v := range models {
+		output = append(output, map[string]interface{}{
+			"identity_ids": v.IdentityIds,
+			"principal_id": v.PrincipalId,
+			"tenant_id":    v.TenantId,
+			"type":         v.Type,
+		})
+	}
+	return output, nil
 }
 
 func expandAppServiceSiteConfig(input interface{}) (*web.SiteConfig, error) {
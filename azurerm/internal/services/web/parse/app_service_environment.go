@@ -67,3 +67,52 @@ func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServiceEnvironmentIDInsensitively parses an AppServiceEnvironment ID into an AppServiceEnvironmentId struct, insensitively
+// This should only be used to parse an ID for rewriting, the AppServiceEnvironmentID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func AppServiceEnvironmentIDInsensitively(input string) (*AppServiceEnvironmentId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AppServiceEnvironmentId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.HostingEnvironmentName, err = id.PopSegmentInsensitively("hostingEnvironments"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeAppServiceEnvironmentID parses the specified AppServiceEnvironment ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeAppServiceEnvironmentID(input string) (string, error) {
+	id, err := AppServiceEnvironmentIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
@@ -67,3 +67,52 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 
 	return &resourceId, nil
 }
+
+// AppServicePlanIDInsensitively parses an AppServicePlan ID into an AppServicePlanId struct, insensitively
+// This should only be used to parse an ID for rewriting, the AppServicePlanID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func AppServicePlanIDInsensitively(input string) (*AppServicePlanId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := AppServicePlanId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerfarmName, err = id.PopSegmentInsensitively("serverfarms"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeAppServicePlanID parses the specified AppServicePlan ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeAppServicePlanID(input string) (string, error) {
+	id, err := AppServicePlanIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
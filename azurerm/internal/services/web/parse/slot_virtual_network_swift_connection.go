@@ -79,3 +79,58 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 
 	return &resourceId, nil
 }
+
+// SlotVirtualNetworkSwiftConnectionIDInsensitively parses an SlotVirtualNetworkSwiftConnection ID into an SlotVirtualNetworkSwiftConnectionId struct, insensitively
+// This should only be used to parse an ID for rewriting, the SlotVirtualNetworkSwiftConnectionID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func SlotVirtualNetworkSwiftConnectionIDInsensitively(input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := SlotVirtualNetworkSwiftConnectionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
+		return nil, err
+	}
+	if resourceId.SlotName, err = id.PopSegmentInsensitively("slots"); err != nil {
+		return nil, err
+	}
+	if resourceId.ConfigName, err = id.PopSegmentInsensitively("config"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// NormalizeSlotVirtualNetworkSwiftConnectionID parses the specified SlotVirtualNetworkSwiftConnection ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func NormalizeSlotVirtualNetworkSwiftConnectionID(input string) (string, error) {
+	id, err := SlotVirtualNetworkSwiftConnectionIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
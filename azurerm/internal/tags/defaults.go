@@ -0,0 +1,162 @@
+package tags
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// DefaultTagsFunc returns the Default Tags defined in the `default_tags` block of the Provider
+type DefaultTagsFunc func(meta interface{}) map[string]string
+
+// SchemaTagsAll returns the Schema used for the `tags_all` attribute, which contains all of the
+// Tags assigned to a resource - including those inherited from the Provider's `default_tags` block
+func SchemaTagsAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// SupportsDefaultTags returns whether the Default Tags can be applied to the specified Resource,
+// which is the case for Resources using `Schema()` (or `SchemaEnforceLowerCaseKeys()`) for `tags`
+func SupportsDefaultTags(resource *schema.Resource) bool {
+	if resource == nil || resource.Schema == nil {
+		return false
+	}
+
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional || v.Computed {
+		return false
+	}
+
+	// a change to the Default Tags can't trigger the recreation of a resource, so these are unsupported
+	return !v.ForceNew
+}
+
+// ApplyDefaultTags updates the Resource so that the Default Tags are merged into the `tags`
+// sent to Azure, exposing the combined set of Tags in the computed `tags_all` attribute.
+//
+// The Default Tags are removed from the `tags` attribute when it's read (unless the tag is
+// defined in the configuration, or has a different value) to avoid a perpetual diff, since
+// these tags aren't defined in the `tags` block of the resource within the configuration.
+func ApplyDefaultTags(resource *schema.Resource, defaultTags DefaultTagsFunc, ignoredTags IgnoreTagsFunc) {
+	if !SupportsDefaultTags(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = SchemaTagsAll()
+
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return withDefaultTags(d, meta, defaultTags, create)
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			configured := d.Get("tags").(map[string]interface{})
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			return setTagsWithoutDefaults(d, defaultTags(meta), configured)
+		}
+	}
+
+	if update := resource.Update; update != nil {
+		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return withDefaultTags(d, meta, defaultTags, update)
+		}
+	}
+
+	customizeDiff := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		// any ignored Tags aren't read into the State, so need to be excluded here to avoid a diff
+		ignored := ignoredTags(meta)
+		all := merge(defaultTags(meta), d.Get("tags").(map[string]interface{}))
+		for k := range all {
+			if ignored.IsIgnored(k) {
+				delete(all, k)
+			}
+		}
+		return d.SetNew("tags_all", all)
+	}
+}
+
+// withDefaultTags merges the Default Tags into the `tags` for the duration of the Create/Update function,
+// so that these are sent to Azure - before removing these from the `tags` once the function completes
+func withDefaultTags(d *schema.ResourceData, meta interface{}, defaultTags DefaultTagsFunc, f func(*schema.ResourceData, interface{}) error) error {
+	defaults := defaultTags(meta)
+	configured := d.Get("tags").(map[string]interface{})
+
+	if err := d.Set("tags", merge(defaults, configured)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	if err := f(d, meta); err != nil {
+		return err
+	}
+
+	return setTagsWithoutDefaults(d, defaults, configured)
+}
+
+// setTagsWithoutDefaults sets `tags_all` to all of the Tags assigned to the resource, and `tags` to the
+// Tags which weren't inherited from the Default Tags - that is, Tags which were configured on the resource
+// (and so must remain in `tags` to avoid a diff), weren't defined in the Default Tags, or have a different value
+func setTagsWithoutDefaults(d *schema.ResourceData, defaults map[string]string, configured map[string]interface{}) error {
+	if d.Id() == "" {
+		// the resource no longer exists
+		return nil
+	}
+
+	all := d.Get("tags").(map[string]interface{})
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range all {
+		_, isConfigured := configured[k]
+		defaultValue, isDefault := defaults[k]
+		if isConfigured || !isDefault || defaultValue != v {
+			output[k] = v
+		}
+	}
+
+	if err := d.Set("tags", output); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// merge returns the Default Tags overlaid with the Tags configured on the resource - where the
+// same key is defined in both, the value configured on the resource takes precedence
+func merge(defaults map[string]string, configured map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(defaults)+len(configured))
+	for k, v := range defaults {
+		output[k] = v
+	}
+	for k, v := range configured {
+		output[k] = v
+	}
+	return output
+}
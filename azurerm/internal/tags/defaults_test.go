@@ -0,0 +1,144 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testDefaultTags(_ interface{}) map[string]string {
+	return map[string]string{
+		"cost-center": "1234",
+		"owner":       "platform",
+	}
+}
+
+func testResourceWithTags(sentTags *map[string]interface{}) *schema.Resource {
+	read := func(d *schema.ResourceData, _ interface{}) error {
+		// the tags returned from the API are all of the tags which were sent
+		return FlattenAndSet(d, Expand(*sentTags))
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": Schema(),
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			*sentTags = d.Get("tags").(map[string]interface{})
+			d.SetId("example")
+			return read(d, meta)
+		},
+		Read: read,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			*sentTags = d.Get("tags").(map[string]interface{})
+			return read(d, meta)
+		},
+		Delete: func(_ *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+}
+
+func TestApplyDefaultTagsCreate(t *testing.T) {
+	var sentTags map[string]interface{}
+	resource := testResourceWithTags(&sentTags)
+	ApplyDefaultTags(resource, testDefaultTags, testIgnoredTags(IgnoreConfig{}))
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("expected the `tags_all` attribute to be added to the schema")
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+			"owner":       "platform",
+		},
+	})
+	if err := resource.Create(d, nil); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedSent := map[string]interface{}{
+		"cost-center": "1234",
+		"environment": "production",
+		"owner":       "platform",
+	}
+	if !reflect.DeepEqual(sentTags, expectedSent) {
+		t.Fatalf("expected the tags %+v to be sent but got %+v", expectedSent, sentTags)
+	}
+
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedSent) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedSent, actual)
+	}
+
+	// `owner` is configured on the resource, so remains in `tags` even though it matches the default
+	expectedTags := map[string]interface{}{
+		"environment": "production",
+		"owner":       "platform",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+}
+
+func TestApplyDefaultTagsRead(t *testing.T) {
+	sentTags := map[string]interface{}{
+		"cost-center": "1234",
+		"environment": "production",
+		"owner":       "someone-else",
+	}
+	resource := testResourceWithTags(&sentTags)
+	ApplyDefaultTags(resource, testDefaultTags, testIgnoredTags(IgnoreConfig{}))
+
+	// importing, where there are no tags in the state
+	d := resource.TestResourceData()
+	d.SetId("example")
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	// `owner` has a different value to the default, so remains in `tags`
+	expectedTags := map[string]interface{}{
+		"environment": "production",
+		"owner":       "someone-else",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, sentTags) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", sentTags, actual)
+	}
+}
+
+func TestApplyDefaultTagsUnsupported(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": ForceNewSchema(),
+		},
+	}
+	ApplyDefaultTags(resource, testDefaultTags, testIgnoredTags(IgnoreConfig{}))
+
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("expected the `tags_all` attribute not to be added to a resource where `tags` is ForceNew")
+	}
+	if resource.CustomizeDiff != nil {
+		t.Fatalf("expected the resource not to be modified")
+	}
+}
+
+func TestMerge(t *testing.T) {
+	actual := merge(map[string]string{
+		"owner": "platform",
+		"team":  "networking",
+	}, map[string]interface{}{
+		"owner": "someone-else",
+	})
+	expected := map[string]interface{}{
+		"owner": "someone-else",
+		"team":  "networking",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,154 @@
+package tags
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// IgnoreConfig defines the Tags which are managed outside of Terraform (for example by Azure Policy),
+// which are neither read into the State nor removed from a resource when it's updated
+type IgnoreConfig struct {
+	// Keys are the keys of Tags which should be ignored, which are matched case-insensitively
+	Keys []string
+
+	// KeyPrefixes are the prefixes of the keys of Tags which should be ignored, which are matched case-insensitively
+	KeyPrefixes []string
+}
+
+// isEmpty returns whether no Tags are ignored
+func (c IgnoreConfig) isEmpty() bool {
+	return len(c.Keys) == 0 && len(c.KeyPrefixes) == 0
+}
+
+// IsIgnored returns whether the Tag with the specified key should be ignored
+func (c IgnoreConfig) IsIgnored(key string) bool {
+	for _, v := range c.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.KeyPrefixes {
+		if v != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IgnoreTagsFunc returns the Tags which are ignored, as defined in the `ignore_tags` block of the Provider
+type IgnoreTagsFunc func(meta interface{}) IgnoreConfig
+
+// ExistingTagsFunc returns the Tags currently assigned to the Resource with the specified ID in Azure
+type ExistingTagsFunc func(meta interface{}, id string) (map[string]string, error)
+
+// ApplyIgnoredTags updates the Resource so that any ignored Tags aren't read into the `tags` attribute, and so
+// that any ignored Tags currently assigned to the resource are sent to Azure when the resource is updated - since
+// these aren't read into the State (and as such aren't in `tags`) they'd otherwise be removed from the resource
+//
+// NOTE: this needs to be applied prior to ApplyDefaultTags, so that the ignored Tags aren't treated
+// as though they were configured on the resource
+func ApplyIgnoredTags(resource *schema.Resource, ignoredTags IgnoreTagsFunc, existingTags ExistingTagsFunc) {
+	if resource == nil || resource.Schema == nil {
+		return
+	}
+
+	if v, ok := resource.Schema["tags"]; !ok || v.Type != schema.TypeMap {
+		return
+	}
+
+	// Create and Update call the Read function directly, so the ignored Tags are removed after each of these
+	if create := resource.Create; create != nil {
+		resource.Create = func(d *schema.ResourceData, meta interface{}) error {
+			if err := create(d, meta); err != nil {
+				return err
+			}
+
+			return setTagsWithoutIgnored(d, ignoredTags(meta))
+		}
+	}
+
+	if read := resource.Read; read != nil {
+		resource.Read = func(d *schema.ResourceData, meta interface{}) error {
+			if err := read(d, meta); err != nil {
+				return err
+			}
+
+			return setTagsWithoutIgnored(d, ignoredTags(meta))
+		}
+	}
+
+	// the ignored Tags are retained for every resource which removes these from the `tags`, regardless of
+	// whether it supports Default Tags, since otherwise updating the resource would remove these from Azure
+	if resource.Update == nil {
+		return
+	}
+
+	update := resource.Update
+	resource.Update = func(d *schema.ResourceData, meta interface{}) error {
+		config := ignoredTags(meta)
+		if config.isEmpty() {
+			return update(d, meta)
+		}
+
+		if err := retainIgnoredTags(d, meta, config, existingTags); err != nil {
+			return err
+		}
+
+		if err := update(d, meta); err != nil {
+			return err
+		}
+
+		return setTagsWithoutIgnored(d, config)
+	}
+}
+
+// retainIgnoredTags adds any ignored Tags currently assigned to the resource to the `tags`
+func retainIgnoredTags(d *schema.ResourceData, meta interface{}, config IgnoreConfig, existingTags ExistingTagsFunc) error {
+	existing, err := existingTags(meta, d.Id())
+	if err != nil {
+		// the ignored Tags are retained on a best-effort basis, since not every resource
+		// supports retrieving it's Tags through the Tags API
+		log.Printf("[WARN] Unable to retrieve the existing Tags for %q, ignored Tags may be removed: %+v", d.Id(), err)
+		return nil
+	}
+
+	output := d.Get("tags").(map[string]interface{})
+	for k, v := range existing {
+		if _, exists := output[k]; exists || !config.IsIgnored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	if err := d.Set("tags", output); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
+
+// setTagsWithoutIgnored removes any ignored Tags from the `tags`
+func setTagsWithoutIgnored(d *schema.ResourceData, config IgnoreConfig) error {
+	if d.Id() == "" || config.isEmpty() {
+		return nil
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		if !config.IsIgnored(k) {
+			output[k] = v
+		}
+	}
+
+	if err := d.Set("tags", output); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func testIgnoredTags(config IgnoreConfig) IgnoreTagsFunc {
+	return func(_ interface{}) IgnoreConfig {
+		return config
+	}
+}
+
+func TestIgnoreConfigIsIgnored(t *testing.T) {
+	config := IgnoreConfig{
+		Keys:        []string{"CreatedBy"},
+		KeyPrefixes: []string{"policy:"},
+	}
+
+	testData := map[string]bool{
+		"CreatedBy":       true,
+		"createdby":       true,
+		"CreatedByUser":   false,
+		"policy:owner":    true,
+		"Policy:Owner":    true,
+		"environment":     false,
+		"owner-policy:ab": false,
+	}
+	for key, expected := range testData {
+		if actual := config.IsIgnored(key); actual != expected {
+			t.Fatalf("expected %q to be ignored %t but got %t", key, expected, actual)
+		}
+	}
+}
+
+func TestApplyIgnoredTagsRead(t *testing.T) {
+	sentTags := map[string]interface{}{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"policy:owner": "platform",
+	}
+	resource := testResourceWithTags(&sentTags)
+	ApplyIgnoredTags(resource, testIgnoredTags(IgnoreConfig{
+		Keys:        []string{"createdBy"},
+		KeyPrefixes: []string{"policy:"},
+	}), nil)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+	d.SetId("example")
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	expected := map[string]interface{}{
+		"environment": "production",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestApplyIgnoredTagsReadMultipleProviders(t *testing.T) {
+	// each Provider block (for example an alias) has it's own `ignore_tags`, which is available from the meta
+	type testMeta struct {
+		ignored IgnoreConfig
+	}
+	first := &testMeta{
+		ignored: IgnoreConfig{
+			Keys: []string{"CreatedBy"},
+		},
+	}
+	second := &testMeta{
+		ignored: IgnoreConfig{
+			KeyPrefixes: []string{"policy:"},
+		},
+	}
+
+	sentTags := map[string]interface{}{
+		"CreatedBy":    "someone",
+		"environment":  "production",
+		"policy:owner": "platform",
+	}
+	resource := testResourceWithTags(&sentTags)
+	ApplyIgnoredTags(resource, func(meta interface{}) IgnoreConfig {
+		return meta.(*testMeta).ignored
+	}, nil)
+
+	testData := []struct {
+		meta     *testMeta
+		expected map[string]interface{}
+	}{
+		{
+			meta: first,
+			expected: map[string]interface{}{
+				"environment":  "production",
+				"policy:owner": "platform",
+			},
+		},
+		{
+			meta: second,
+			expected: map[string]interface{}{
+				"CreatedBy":   "someone",
+				"environment": "production",
+			},
+		},
+	}
+	for _, v := range testData {
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		d.SetId("example")
+		if err := resource.Read(d, v.meta); err != nil {
+			t.Fatalf("reading: %+v", err)
+		}
+
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected `tags` to be %+v but got %+v", v.expected, actual)
+		}
+	}
+}
+
+func TestApplyIgnoredTagsUpdate(t *testing.T) {
+	ignoredTags := testIgnoredTags(IgnoreConfig{
+		KeyPrefixes: []string{"policy:"},
+	})
+
+	existingTags := func(_ interface{}, _ string) (map[string]string, error) {
+		return map[string]string{
+			"environment":  "staging",
+			"policy:owner": "platform",
+		}, nil
+	}
+
+	var sentTags map[string]interface{}
+	resource := testResourceWithTags(&sentTags)
+	ApplyIgnoredTags(resource, ignoredTags, existingTags)
+	ApplyDefaultTags(resource, func(_ interface{}) map[string]string {
+		return map[string]string{}
+	}, ignoredTags)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	})
+	d.SetId("example")
+	if err := resource.Update(d, nil); err != nil {
+		t.Fatalf("updating: %+v", err)
+	}
+
+	// the ignored tag is retained, whilst the configured tag takes precedence
+	expectedSent := map[string]interface{}{
+		"environment":  "production",
+		"policy:owner": "platform",
+	}
+	if !reflect.DeepEqual(sentTags, expectedSent) {
+		t.Fatalf("expected the tags %+v to be sent but got %+v", expectedSent, sentTags)
+	}
+
+	// whilst the ignored tag isn't read into the state
+	expectedTags := map[string]interface{}{
+		"environment": "production",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedTags, actual)
+	}
+}
+
+func TestApplyIgnoredTagsUpdateWithoutDefaultTags(t *testing.T) {
+	existingTags := func(_ interface{}, _ string) (map[string]string, error) {
+		return map[string]string{
+			"policy:owner": "platform",
+		}, nil
+	}
+
+	var sentTags map[string]interface{}
+	resource := testResourceWithTags(&sentTags)
+
+	// Optional & Computed tags don't support Default Tags, but still have the ignored Tags removed when read
+	resource.Schema["tags"].Computed = true
+	if SupportsDefaultTags(resource) {
+		t.Fatalf("expected the resource not to support Default Tags")
+	}
+	ApplyIgnoredTags(resource, testIgnoredTags(IgnoreConfig{
+		KeyPrefixes: []string{"policy:"},
+	}), existingTags)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	})
+	d.SetId("example")
+	if err := resource.Update(d, nil); err != nil {
+		t.Fatalf("updating: %+v", err)
+	}
+
+	expectedSent := map[string]interface{}{
+		"environment":  "production",
+		"policy:owner": "platform",
+	}
+	if !reflect.DeepEqual(sentTags, expectedSent) {
+		t.Fatalf("expected the tags %+v to be sent but got %+v", expectedSent, sentTags)
+	}
+
+	expectedTags := map[string]interface{}{
+		"environment": "production",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+}
@@ -0,0 +1,131 @@
+package timeouts
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+// DefaultsFunc returns the default Timeouts specified in the `features` block of the Provider
+type DefaultsFunc func(meta interface{}) features.TimeoutsFeatures
+
+// configuredDefaults is a map of the ResourceData for each in-progress operation to the default Timeouts
+// configured for that Resource - which are resolved by ForCreate/ForRead/ForUpdate/ForDelete
+var configuredDefaults sync.Map
+
+type resourceDefaults struct {
+	compiled   *schema.ResourceTimeout
+	configured features.OperationTimeouts
+
+	// the Plugin SDK doesn't populate the Timeouts for a Data Source, so the configured defaults always apply
+	dataSource bool
+}
+
+// ApplyDefaults updates the Resource so that the default Timeouts specified in the `features` block of
+// the Provider are used for each operation - preferring those defined for the Resource Type, then those
+// defined for the Service, before falling back to the default Timeouts compiled into the Resource.
+//
+// Since each Provider block can specify it's own defaults, these are resolved when each operation is run
+// rather than by overriding the Timeouts of the Resource, which are left unchanged. A Timeout defined in
+// the `timeouts` block of the Resource takes precedence, unless it matches the compiled default.
+func ApplyDefaults(resource *schema.Resource, resourceType string, serviceName string, defaults DefaultsFunc) {
+	if resource == nil || resource.Timeouts == nil {
+		return
+	}
+
+	compiled := resource.Timeouts
+	dataSource := resource.Create == nil
+	wrap := func(operation func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if operation == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			configuredDefaults.Store(d, resourceDefaults{
+				compiled:   compiled,
+				configured: DefaultsFor(resourceType, serviceName, defaults(meta)),
+				dataSource: dataSource,
+			})
+			defer configuredDefaults.Delete(d)
+
+			return operation(d, meta)
+		}
+	}
+
+	resource.Create = wrap(resource.Create)
+	resource.Read = wrap(resource.Read)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+}
+
+// DefaultsFor returns the default Timeouts configured in the `features` block of the Provider for
+// the specified Resource Type - where each operation uses the Timeout for the Resource Type if
+// specified, else the Timeout for the Service (matched case-insensitively) - or zero if neither is
+func DefaultsFor(resourceType string, serviceName string, input features.TimeoutsFeatures) features.OperationTimeouts {
+	output := features.OperationTimeouts{}
+
+	if v, ok := input.ResourceTypes[resourceType]; ok {
+		output = v
+	}
+
+	for name, v := range input.Services {
+		if serviceName == "" || !strings.EqualFold(name, serviceName) {
+			continue
+		}
+
+		output.Create = fallbackTimeout(output.Create, v.Create)
+		output.Read = fallbackTimeout(output.Read, v.Read)
+		output.Update = fallbackTimeout(output.Update, v.Update)
+		output.Delete = fallbackTimeout(output.Delete, v.Delete)
+	}
+
+	return output
+}
+
+// timeoutFor returns the Timeout for the specified operation, using the default Timeout specified in
+// the `features` block of the Provider when the Timeout for the operation is the compiled default
+func timeoutFor(d *schema.ResourceData, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	v, ok := configuredDefaults.Load(d)
+	if !ok {
+		return timeout
+	}
+	defaults := v.(resourceDefaults)
+
+	var compiled *time.Duration
+	var configured time.Duration
+	switch key {
+	case schema.TimeoutCreate:
+		compiled, configured = defaults.compiled.Create, defaults.configured.Create
+	case schema.TimeoutRead:
+		compiled, configured = defaults.compiled.Read, defaults.configured.Read
+	case schema.TimeoutUpdate:
+		compiled, configured = defaults.compiled.Update, defaults.configured.Update
+	case schema.TimeoutDelete:
+		compiled, configured = defaults.compiled.Delete, defaults.configured.Delete
+	}
+
+	// the Plugin SDK raises an error when a Timeout is configured for an operation
+	// which isn't supported, so only the supported operations can be overridden
+	if compiled == nil || configured == 0 {
+		return timeout
+	}
+
+	if defaults.dataSource || *compiled == timeout {
+		return configured
+	}
+
+	return timeout
+}
+
+func fallbackTimeout(value time.Duration, fallback time.Duration) time.Duration {
+	if value != 0 {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,219 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func testResourceWithTimeouts(timeouts *schema.ResourceTimeout, actual map[string]time.Duration) *schema.Resource {
+	record := func(ctx context.Context, key string) {
+		deadline, _ := ctx.Deadline()
+		actual[key] = time.Until(deadline).Round(time.Minute)
+	}
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Create: func(d *schema.ResourceData, _ interface{}) error {
+			ctx, cancel := ForCreate(context.Background(), d)
+			defer cancel()
+			record(ctx, schema.TimeoutCreate)
+			return nil
+		},
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			ctx, cancel := ForRead(context.Background(), d)
+			defer cancel()
+			record(ctx, schema.TimeoutRead)
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, _ interface{}) error {
+			ctx, cancel := ForDelete(context.Background(), d)
+			defer cancel()
+			record(ctx, schema.TimeoutDelete)
+			return nil
+		},
+		Timeouts: timeouts,
+	}
+}
+
+func testDefaults(meta interface{}) features.TimeoutsFeatures {
+	return meta.(features.TimeoutsFeatures)
+}
+
+func TestApplyDefaults(t *testing.T) {
+	compiled := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(30 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Delete: schema.DefaultTimeout(30 * time.Minute),
+	}
+	input := features.TimeoutsFeatures{
+		ResourceTypes: map[string]features.OperationTimeouts{
+			"azurerm_kubernetes_cluster": {
+				Create: 3 * time.Hour,
+				Update: 2 * time.Hour,
+			},
+		},
+		Services: map[string]features.OperationTimeouts{
+			"compute": {
+				Create: 90 * time.Minute,
+				Delete: time.Hour,
+			},
+			"containers": {
+				Create: time.Hour,
+				Read:   10 * time.Minute,
+			},
+		},
+	}
+
+	testData := []struct {
+		resourceType string
+		serviceName  string
+		expected     map[string]time.Duration
+	}{
+		{
+			resourceType: "azurerm_kubernetes_cluster",
+			serviceName:  "Containers",
+			expected: map[string]time.Duration{
+				schema.TimeoutCreate: 3 * time.Hour,
+				schema.TimeoutRead:   10 * time.Minute,
+				schema.TimeoutDelete: 30 * time.Minute,
+			},
+		},
+		{
+			resourceType: "azurerm_linux_virtual_machine",
+			serviceName:  "Compute",
+			expected: map[string]time.Duration{
+				schema.TimeoutCreate: 90 * time.Minute,
+				schema.TimeoutRead:   5 * time.Minute,
+				schema.TimeoutDelete: time.Hour,
+			},
+		},
+		{
+			resourceType: "azurerm_resource_group",
+			serviceName:  "Resources",
+			expected: map[string]time.Duration{
+				schema.TimeoutCreate: 30 * time.Minute,
+				schema.TimeoutRead:   5 * time.Minute,
+				schema.TimeoutDelete: 30 * time.Minute,
+			},
+		},
+	}
+	for _, v := range testData {
+		actual := make(map[string]time.Duration)
+		resource := testResourceWithTimeouts(compiled, actual)
+		ApplyDefaults(resource, v.resourceType, v.serviceName, testDefaults)
+
+		d := resource.Data(nil)
+		if err := resource.Create(d, input); err != nil {
+			t.Fatalf("creating %q: %+v", v.resourceType, err)
+		}
+		if err := resource.Read(d, input); err != nil {
+			t.Fatalf("reading %q: %+v", v.resourceType, err)
+		}
+		if err := resource.Delete(d, input); err != nil {
+			t.Fatalf("deleting %q: %+v", v.resourceType, err)
+		}
+
+		for key, expected := range v.expected {
+			if actual[key] != expected {
+				t.Fatalf("expected a %s timeout of %s for %q but got %s", key, expected, v.resourceType, actual[key])
+			}
+		}
+
+		// the compiled defaults shouldn't have been modified, since these can be shared
+		if resource.Timeouts != compiled || *compiled.Create != 30*time.Minute {
+			t.Fatalf("expected the compiled defaults for %q to be unchanged", v.resourceType)
+		}
+	}
+}
+
+func TestApplyDefaultsMultipleProviders(t *testing.T) {
+	compiled := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(30 * time.Minute),
+	}
+	actual := make(map[string]time.Duration)
+	resource := testResourceWithTimeouts(compiled, actual)
+	ApplyDefaults(resource, "azurerm_resource_group", "Resources", testDefaults)
+
+	// each Provider block (for example an alias) has it's own defaults, which are available from the meta
+	testData := map[time.Duration]features.TimeoutsFeatures{
+		time.Hour: {
+			ResourceTypes: map[string]features.OperationTimeouts{
+				"azurerm_resource_group": {
+					Create: time.Hour,
+				},
+			},
+		},
+		30 * time.Minute: {},
+	}
+	for expected, input := range testData {
+		if err := resource.Create(resource.Data(nil), input); err != nil {
+			t.Fatalf("creating: %+v", err)
+		}
+
+		if actual[schema.TimeoutCreate] != expected {
+			t.Fatalf("expected a timeout of %s but got %s", expected, actual[schema.TimeoutCreate])
+		}
+	}
+}
+
+func TestApplyDefaultsConfiguredTimeout(t *testing.T) {
+	compiled := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(30 * time.Minute),
+	}
+	actual := make(map[string]time.Duration)
+	resource := testResourceWithTimeouts(compiled, actual)
+	ApplyDefaults(resource, "azurerm_resource_group", "Resources", testDefaults)
+	input := features.TimeoutsFeatures{
+		ResourceTypes: map[string]features.OperationTimeouts{
+			"azurerm_resource_group": {
+				Create: time.Hour,
+			},
+		},
+	}
+
+	// a Timeout defined in the `timeouts` block of the Resource takes precedence over the defaults
+	configured := &schema.Resource{
+		Schema: resource.Schema,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+	}
+	if err := resource.Create(configured.Data(nil), input); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	if expected := 45 * time.Minute; actual[schema.TimeoutCreate] != expected {
+		t.Fatalf("expected a timeout of %s but got %s", expected, actual[schema.TimeoutCreate])
+	}
+}
+
+func TestApplyDefaultsDataSource(t *testing.T) {
+	actual := make(map[string]time.Duration)
+	dataSource := testResourceWithTimeouts(&schema.ResourceTimeout{
+		Read: schema.DefaultTimeout(5 * time.Minute),
+	}, actual)
+	dataSource.Create = nil
+	dataSource.Delete = nil
+	ApplyDefaults(dataSource, "azurerm_resource_group", "Resources", testDefaults)
+	input := features.TimeoutsFeatures{
+		Services: map[string]features.OperationTimeouts{
+			"Resources": {
+				Read: 10 * time.Minute,
+			},
+		},
+	}
+
+	// the Plugin SDK doesn't populate the Timeouts when reading a Data Source
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{})
+	if err := dataSource.Read(d, input); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	if expected := 10 * time.Minute; actual[schema.TimeoutRead] != expected {
+		t.Fatalf("expected a timeout of %s but got %s", expected, actual[schema.TimeoutRead])
+	}
+}
@@ -17,7 +17,6 @@ func main() {
 	servicePackagePath := flag.String("path", "", "The relative path to the service package")
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
-	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -27,12 +26,12 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, *id); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+func run(servicePackagePath, name, id string) error {
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -60,8 +59,7 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 	}
 
 	generator := ResourceIdGenerator{
-		ResourceId:    *resourceId,
-		ShouldRewrite: shouldRewrite,
+		ResourceId: *resourceId,
 	}
 
 	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
@@ -305,8 +303,6 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 
 type ResourceIdGenerator struct {
 	ResourceId
-
-	ShouldRewrite bool
 }
 
 func (id ResourceIdGenerator) Code() string {
@@ -328,7 +324,8 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive(), id.codeForNormalizer())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -470,11 +467,6 @@ func %[1]sID(input string) (*%[1]sId, error) {
 }
 
 func (id ResourceIdGenerator) codeForParserInsensitive() string {
-	if !id.ShouldRewrite {
-		// this only exists to workaround broken API's to patch those ID's, so shouldn't be used in most circumstances
-		return ""
-	}
-
 	directAssignments := make([]string, 0)
 	if id.HasSubscriptionId {
 		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
@@ -497,21 +489,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 			continue
 		}
 
-		// NOTE: This becomes dramatically simpler long-term - but for now has to be long-winded
-		// to avoid subtle changes to resources until this is threaded through everywhere
-		fmtString := `
-  // find the correct casing for the '%[2]s' segment
-  %[2]sKey := "%[2]s"
-  for key := range id.Path {
-  	if strings.EqualFold(key, %[2]sKey) {
-  		%[2]sKey = key
-  		break
-  	}
-  }
-  if resourceId.%[1]s, err = id.PopSegment(%[2]sKey); err != nil {
-    return nil, err
-  }
-`
+		fmtString := "\tif resourceId.%[1]s, err = id.PopSegmentInsensitively(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
 		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
 	}
 	parserStatementsStr := strings.Join(parserStatements, "\n")
@@ -523,7 +501,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -543,6 +521,23 @@ func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
 `, id.TypeName, directAssignmentsStr, parserStatementsStr)
 }
 
+func (id ResourceIdGenerator) codeForNormalizer() string {
+	return fmt.Sprintf(`
+// Normalize%[1]sID parses the specified %[1]s ID insensitively and returns it
+// using the canonical casing for each of the segments (e.g. rewriting 'resourcegroups'
+// to 'resourceGroups'), so that an ID which differs only by casing (for example
+// one specified when importing a resource) doesn't cause a diff
+func Normalize%[1]sID(input string) (string, error) {
+	id, err := %[1]sIDInsensitively(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
+`, id.TypeName)
+}
+
 func (id ResourceIdGenerator) TestCode() string {
 	return fmt.Sprintf(`
 package parse
@@ -550,7 +545,10 @@ package parse
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
+	"math/rand"
+	"strings"
 	"testing"
+	"unicode"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
@@ -558,15 +556,13 @@ import (
 %s
 %s
 %s
-`, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+%s
+%s
+`, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive(), id.testCodeForNormalizer(), id.testCodeForRoundTrip(), id.testCodeForFuzz())
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
-	arguments := make([]string, 0)
-	for _, segment := range id.Segments {
-		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
-	}
-	arguementsStr := strings.Join(arguments, ", ")
 	return fmt.Sprintf(`
 var _ resourceid.Formatter = %[1]sId{}
 
@@ -577,7 +573,7 @@ func Test%[1]sIDFormatter(t *testing.T) {
 		t.Fatalf("Expected %%q but got %%q", expected, actual)
 	}
 }
-`, id.TypeName, arguementsStr, id.IDRaw)
+`, id.TypeName, id.constructorTestArguments(), id.IDRaw)
 }
 
 func (id ResourceIdGenerator) testCodeForParser() string {
@@ -667,12 +663,30 @@ func Test%[1]sID(t *testing.T) {
 `, id.TypeName, testCasesStr, assignmentCheckStr)
 }
 
-func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
-	if !id.ShouldRewrite {
-		// this functionality isn't enabled by default
-		return ""
+// resourceIdWithTransformedKeys returns the example Resource ID with the specified transformation applied
+// to each of the keys (e.g. `resourceGroups`) - and optionally the name of the Resource Provider
+func (id ResourceIdGenerator) resourceIdWithTransformedKeys(transform func(in string) string, includeProvider bool) string {
+	// the first component is empty since the ID is prefixed with a `/`, so the keys are at the odd indexes
+	components := strings.Split(id.IDRaw, "/")
+	for i := 1; i < len(components); i += 2 {
+		if includeProvider && components[i] == "providers" && i+1 < len(components) {
+			components[i+1] = transform(components[i+1])
+		}
+
+		components[i] = transform(components[i])
+	}
+	return strings.Join(components, "/")
+}
+
+func (id ResourceIdGenerator) constructorTestArguments() string {
+	arguments := make([]string, 0)
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
 	}
+	return strings.Join(arguments, ", ")
+}
 
+func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 	testCases := make([]string, 0)
 	testCases = append(testCases, `
 		{
@@ -719,16 +733,7 @@ func (id ResourceIdGenerator) testCodeForParserInsensitive() string {
 `, id.IDRaw, id.TypeName, strings.Join(expectAssignments, "\n")))
 
 	var testCaseWithTransformation = func(testCaseName string, transform func(in string) string) string {
-		resourceIdWithTransform := id.IDRaw
-		for _, segment := range id.Segments {
-			// we're not as concerned with these two for now
-			if segment.FieldName == "SubscriptionId" || segment.FieldName == "ResourceGroup" {
-				continue
-			}
-
-			transformedKey := transform(segment.SegmentKey)
-			resourceIdWithTransform = strings.Replace(resourceIdWithTransform, segment.SegmentKey, transformedKey, 1)
-		}
+		resourceIdWithTransform := id.resourceIdWithTransformedKeys(transform, false)
 		return fmt.Sprintf(`
 		{
 			// %[4]s
@@ -786,6 +791,152 @@ func Test%[1]sIDInsensitively(t *testing.T) {
 `, id.TypeName, testCasesStr, assignmentCheckStr)
 }
 
+func (id ResourceIdGenerator) testCodeForNormalizer() string {
+	testCases := []string{
+		`
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},`,
+		fmt.Sprintf(`
+		{
+			// valid
+			Input:    %[1]q,
+			Expected: %[1]q,
+		},`, id.IDRaw),
+		fmt.Sprintf(`
+		{
+			// lower-cased segment names
+			Input:    %q,
+			Expected: %q,
+		},`, id.resourceIdWithTransformedKeys(strings.ToLower, false), id.IDRaw),
+		fmt.Sprintf(`
+		{
+			// upper-cased segment names
+			Input:    %q,
+			Expected: %q,
+		},`, id.resourceIdWithTransformedKeys(strings.ToUpper, false), id.IDRaw),
+	}
+
+	if withProvider := id.resourceIdWithTransformedKeys(strings.ToLower, true); withProvider != id.resourceIdWithTransformedKeys(strings.ToLower, false) {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// lower-cased segment names and resource provider
+			Input:    %q,
+			Expected: %q,
+		},`, withProvider, id.IDRaw))
+	}
+
+	return fmt.Sprintf(`
+func TestNormalize%[1]sID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected string
+	}{
+%[2]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := Normalize%[1]sID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %%q but got %%q", v.Expected, actual)
+		}
+	}
+}
+`, id.TypeName, strings.Join(testCases, "\n"))
+}
+
+func (id ResourceIdGenerator) testCodeForRoundTrip() string {
+	return fmt.Sprintf(`
+func Test%[1]sIDRoundTrip(t *testing.T) {
+	expected := New%[1]sID(%[2]s)
+
+	actual, err := %[1]sID(expected.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %%s", err)
+	}
+	if *actual != expected {
+		t.Fatalf("Expected %%+v but got %%+v", expected, *actual)
+	}
+
+	normalized, err := Normalize%[1]sID(actual.ID())
+	if err != nil {
+		t.Fatalf("Expect a value but got an error: %%s", err)
+	}
+	if normalized != expected.ID() {
+		t.Fatalf("Expected %%q but got %%q", expected.ID(), normalized)
+	}
+}
+`, id.TypeName, id.constructorTestArguments())
+}
+
+func (id ResourceIdGenerator) testCodeForFuzz() string {
+	return fmt.Sprintf(`
+func Test%[1]sIDInsensitivelyFuzz(t *testing.T) {
+	expected := New%[1]sID(%[2]s)
+
+	// a fixed seed is used so that any failures are reproducible
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		// the casing of each of the segment names (and the resource provider) is randomised, the values
+		// are case-sensitive so are left as-is
+		components := strings.Split(expected.ID(), "/")
+		for j := 1; j < len(components); j += 2 {
+			indexes := []int{j}
+			if components[j] == "providers" && j+1 < len(components) {
+				indexes = append(indexes, j+1)
+			}
+
+			for _, index := range indexes {
+				chars := []rune(components[index])
+				for k := range chars {
+					if random.Intn(2) == 0 {
+						chars[k] = unicode.ToUpper(chars[k])
+					} else {
+						chars[k] = unicode.ToLower(chars[k])
+					}
+				}
+				components[index] = string(chars)
+			}
+		}
+		input := strings.Join(components, "/")
+		t.Logf("[DEBUG] Testing %%q", input)
+
+		actual, err := %[1]sIDInsensitively(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if *actual != expected {
+			t.Fatalf("Expected %%+v but got %%+v", expected, *actual)
+		}
+
+		normalized, err := Normalize%[1]sID(input)
+		if err != nil {
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if normalized != expected.ID() {
+			t.Fatalf("Expected %%q but got %%q", expected.ID(), normalized)
+		}
+	}
+}
+`, id.TypeName, id.constructorTestArguments())
+}
+
 func (id ResourceIdGenerator) ValidatorCode() string {
 	return fmt.Sprintf(`package validate
 
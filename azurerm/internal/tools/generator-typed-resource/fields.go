@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// modelField is a field within the generated Model, which maps to a field within the Properties of the SDK Model
+type modelField struct {
+	// FieldName is the name of this field within both the generated Model and the SDK Model, e.g. `UserMetadata`
+	FieldName string
+
+	// SchemaName is the name of this field within the Schema, e.g. `user_metadata`
+	SchemaName string
+
+	// GoType is the type of this field within the generated Model, e.g. `string`
+	GoType string
+
+	// ReadOnly specifies whether this field is exposed as an Attribute rather than an Argument
+	ReadOnly bool
+
+	// Supported specifies whether the type of this field is supported by the generator - when it's not
+	// a TODO is output instead, since this needs to be mapped by hand
+	Supported bool
+
+	sdkField       sdkField
+	sdkPackageName string
+}
+
+func newModelField(sdkPackageName string, field sdkField) modelField {
+	output := modelField{
+		FieldName:      field.Name,
+		SchemaName:     convertToSnakeCase(field.Name),
+		ReadOnly:       field.ReadOnly,
+		Supported:      true,
+		sdkField:       field,
+		sdkPackageName: sdkPackageName,
+	}
+
+	// the name used in the API is preferred since it's got consistent casing, e.g. `allowedIps` rather than `AllowedIPs`
+	if field.JsonName != "" && field.JsonName != "-" {
+		output.SchemaName = convertToSnakeCase(field.JsonName)
+	}
+
+	switch {
+	case field.Type == "*string" || len(field.EnumValues) > 0:
+		output.GoType = "string"
+	case field.Type == "*bool":
+		output.GoType = "bool"
+	case field.Type == "*int32" || field.Type == "*int64":
+		output.GoType = "int64"
+	case field.Type == "*float64":
+		output.GoType = "float64"
+	case field.Type == "*[]string":
+		output.GoType = "[]string"
+	default:
+		output.Supported = false
+	}
+
+	return output
+}
+
+func (f modelField) isEnum() bool {
+	return len(f.sdkField.EnumValues) > 0
+}
+
+// modelFieldCode returns the definition of this field within the generated Model
+func (f modelField) modelFieldCode() string {
+	if !f.Supported {
+		return fmt.Sprintf("\t// TODO: map the field %q (of type %q) from the SDK Model\n", f.FieldName, f.sdkField.Type)
+	}
+
+	return fmt.Sprintf("\t%s %s `tfschema:%q`\n", f.FieldName, f.GoType, f.SchemaName)
+}
+
+// schemaCode returns the Schema for this field, when it's an Argument
+func (f modelField) schemaCode() string {
+	if !f.Supported {
+		return fmt.Sprintf("\t\t// TODO: add the Schema for the field %q (of type %q)\n", f.SchemaName, f.sdkField.Type)
+	}
+
+	if f.ReadOnly {
+		return fmt.Sprintf(`
+		%q: {
+			Type:     %s,
+			Computed: true,%s
+		},
+`, f.SchemaName, f.schemaType(), f.elemCode())
+	}
+
+	validateFunc := ""
+	switch {
+	case f.isEnum():
+		values := make([]string, 0)
+		for _, v := range f.sdkField.EnumValues {
+			values = append(values, fmt.Sprintf("\t\t\t\tstring(%s.%s),", f.sdkPackageName, v))
+		}
+		validateFunc = fmt.Sprintf(`
+			ValidateFunc: validation.StringInSlice([]string{
+%s
+			}, false),`, strings.Join(values, "\n"))
+	case f.GoType == "string":
+		validateFunc = `
+			ValidateFunc: validation.StringIsNotEmpty,`
+	}
+
+	return fmt.Sprintf(`
+		%q: {
+			Type:     %s,
+			Optional: true,%s%s
+		},
+`, f.SchemaName, f.schemaType(), f.elemCode(), validateFunc)
+}
+
+func (f modelField) schemaType() string {
+	switch f.GoType {
+	case "bool":
+		return "schema.TypeBool"
+	case "int64":
+		return "schema.TypeInt"
+	case "float64":
+		return "schema.TypeFloat"
+	case "[]string":
+		return "schema.TypeList"
+	}
+
+	return "schema.TypeString"
+}
+
+func (f modelField) elemCode() string {
+	if f.GoType != "[]string" {
+		return ""
+	}
+
+	return `
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},`
+}
+
+// expandCode returns the assignment of this field within the SDK Model, using the value from the generated Model
+func (f modelField) expandCode(modelVariableName string) string {
+	value := fmt.Sprintf("%s.%s", modelVariableName, f.FieldName)
+	if !f.Supported {
+		return fmt.Sprintf("\t\t// TODO: map the field %q (of type %q)\n", f.FieldName, f.sdkField.Type)
+	}
+
+	switch {
+	case f.isEnum():
+		value = fmt.Sprintf("%s.%s(%s)", f.sdkPackageName, f.sdkField.Type, value)
+	case f.sdkField.Type == "*string":
+		value = fmt.Sprintf("utils.String(%s)", value)
+	case f.sdkField.Type == "*bool":
+		value = fmt.Sprintf("utils.Bool(%s)", value)
+	case f.sdkField.Type == "*int32":
+		value = fmt.Sprintf("utils.Int32(int32(%s))", value)
+	case f.sdkField.Type == "*int64":
+		value = fmt.Sprintf("utils.Int64(%s)", value)
+	case f.sdkField.Type == "*float64":
+		value = fmt.Sprintf("utils.Float(%s)", value)
+	case f.sdkField.Type == "*[]string":
+		value = fmt.Sprintf("&%s", value)
+	}
+
+	return fmt.Sprintf("\t\t%s: %s,\n", f.FieldName, value)
+}
+
+// flattenCode returns the assignment of this field within the generated Model, using the value from the SDK Model
+func (f modelField) flattenCode(propertiesVariableName, modelVariableName string) string {
+	value := fmt.Sprintf("%s.%s", propertiesVariableName, f.FieldName)
+	if !f.Supported {
+		return fmt.Sprintf("\t// TODO: map the field %q (of type %q)\n", f.FieldName, f.sdkField.Type)
+	}
+
+	switch {
+	case f.isEnum():
+		return fmt.Sprintf("\t%s.%s = string(%s)\n", modelVariableName, f.FieldName, value)
+	case f.sdkField.Type == "*string":
+		return fmt.Sprintf("\t%s.%s = utils.NormalizeNilableString(%s)\n", modelVariableName, f.FieldName, value)
+	case f.sdkField.Type == "*int32":
+		return fmt.Sprintf(`	if %[3]s != nil {
+		%[1]s.%[2]s = int64(*%[3]s)
+	}
+`, modelVariableName, f.FieldName, value)
+	}
+
+	return fmt.Sprintf(`	if %[3]s != nil {
+		%[1]s.%[2]s = *%[3]s
+	}
+`, modelVariableName, f.FieldName, value)
+}
@@ -0,0 +1,456 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const providerImportPath = "github.com/terraform-providers/terraform-provider-azurerm/azurerm"
+
+type resourceGenerator struct {
+	// ServicePackageName is the name of the Service Package this Resource is generated into, e.g. `eventhub`
+	ServicePackageName string
+
+	// ResourceName is the name of this Resource, e.g. `azurerm_eventhub_consumer_group`
+	ResourceName string
+
+	// ClientName is the path to the SDK Client within the Clients struct, e.g. `Eventhub.ConsumerGroupClient`
+	ClientName string
+
+	// ResourceId is the Resource ID used for this Resource
+	ResourceId resourceId
+
+	// Model is the Model within the Azure SDK which is used for this Resource
+	Model sdkModel
+}
+
+func (g resourceGenerator) resourceTypeName() string {
+	return fmt.Sprintf("%sResource", g.ResourceId.TypeName)
+}
+
+func (g resourceGenerator) modelTypeName() string {
+	return fmt.Sprintf("%sModel", g.ResourceId.TypeName)
+}
+
+func (g resourceGenerator) fields() []modelField {
+	output := make([]modelField, 0)
+	for _, field := range g.Model.Fields {
+		output = append(output, newModelField(g.Model.PackageName, field))
+	}
+	return output
+}
+
+// updatable returns whether any of the fields within this Resource can be updated, in which
+// case an Update function is generated
+func (g resourceGenerator) updatable() bool {
+	if g.Model.HasTags {
+		return true
+	}
+
+	for _, field := range g.fields() {
+		if field.Supported && !field.ReadOnly {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g resourceGenerator) Code() string {
+	return fmt.Sprintf(`package %[1]s
+
+%[2]s
+
+%[3]s
+%[4]s
+%[5]s
+%[6]s
+%[7]s
+%[8]s
+%[9]s
+%[10]s
+%[11]s
+%[12]s
+%[13]s
+`, g.ServicePackageName, g.codeForImports(), g.codeForModel(), g.codeForResource(), g.codeForArguments(), g.codeForAttributes(), g.codeForCreate(), g.codeForRead(), g.codeForUpdate(), g.codeForDelete(), g.codeForIDValidation(), g.codeForExpand(), g.codeForFlatten())
+}
+
+func (g resourceGenerator) codeForImports() string {
+	imports := []string{
+		"context",
+		"fmt",
+		"time",
+		"github.com/hashicorp/terraform-plugin-sdk/helper/schema",
+		"github.com/hashicorp/terraform-plugin-sdk/helper/validation",
+		g.Model.PackagePath,
+		fmt.Sprintf("%s/helpers/azure", providerImportPath),
+		fmt.Sprintf("%s/internal/sdk", providerImportPath),
+		fmt.Sprintf("%s/internal/services/%s/parse", providerImportPath, g.ServicePackageName),
+		fmt.Sprintf("%s/internal/services/%s/validate", providerImportPath, g.ServicePackageName),
+		fmt.Sprintf("%s/utils", providerImportPath),
+	}
+	if g.Model.HasLocation {
+		imports = append(imports, fmt.Sprintf("%s/internal/location", providerImportPath))
+	}
+	if g.Model.HasTags {
+		imports = append(imports, fmt.Sprintf("%s/internal/tags", providerImportPath))
+	}
+
+	return codeForImportBlock(imports)
+}
+
+// codeForImportBlock returns an import block with the standard library imports grouped
+// separately from the other imports, matching the layout used throughout the Provider
+func codeForImportBlock(imports []string) string {
+	standard := make([]string, 0)
+	external := make([]string, 0)
+	for _, v := range imports {
+		line := fmt.Sprintf("\t%q", v)
+		if strings.Contains(strings.Split(v, "/")[0], ".") {
+			external = append(external, line)
+			continue
+		}
+		standard = append(standard, line)
+	}
+	sort.Strings(standard)
+	sort.Strings(external)
+
+	return fmt.Sprintf(`import (
+%s
+
+%s
+)`, strings.Join(standard, "\n"), strings.Join(external, "\n"))
+}
+
+func (g resourceGenerator) codeForModel() string {
+	fields := make([]string, 0)
+	for _, segment := range g.ResourceId.schemaSegments() {
+		fields = append(fields, fmt.Sprintf("\t%s string `tfschema:%q`\n", segment.ModelFieldName, segment.SchemaName))
+	}
+	if g.Model.HasLocation {
+		fields = append(fields, "\tLocation string `tfschema:\"location\"`\n")
+	}
+	unsupported := make([]string, 0)
+	for _, field := range g.fields() {
+		if !field.Supported {
+			unsupported = append(unsupported, field.modelFieldCode())
+			continue
+		}
+		fields = append(fields, field.modelFieldCode())
+	}
+	if g.Model.HasTags {
+		fields = append(fields, "\tTags map[string]string `tfschema:\"tags\"`\n")
+	}
+	if len(unsupported) > 0 {
+		// these are output after the other fields so the alignment of the other fields isn't affected
+		fields = append(fields, "\n")
+		fields = append(fields, unsupported...)
+	}
+
+	return fmt.Sprintf(`type %[1]s struct {
+%[2]s}
+`, g.modelTypeName(), strings.Join(fields, ""))
+}
+
+func (g resourceGenerator) codeForResource() string {
+	updateAssertion := ""
+	if g.updatable() {
+		updateAssertion = fmt.Sprintf("var _ sdk.ResourceWithUpdate = %s{}\n", g.resourceTypeName())
+	}
+	updateAssertion += "\n"
+
+	return fmt.Sprintf(`var _ sdk.Resource = %[1]s{}
+var _ sdk.ResourceWithIDNormalization = %[1]s{}
+%[3]s
+type %[1]s struct{}
+
+func (r %[1]s) ResourceType() string {
+	return %[2]q
+}
+
+func (r %[1]s) ModelObject() interface{} {
+	return %[4]s{}
+}
+`, g.resourceTypeName(), g.ResourceName, updateAssertion, g.modelTypeName())
+}
+
+func (g resourceGenerator) codeForArguments() string {
+	arguments := make([]string, 0)
+	for _, segment := range g.ResourceId.schemaSegments() {
+		if segment.FieldName == "ResourceGroup" {
+			arguments = append(arguments, "\t\t\"resource_group_name\": azure.SchemaResourceGroupName(),\n")
+			continue
+		}
+
+		arguments = append(arguments, fmt.Sprintf(`
+		%q: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+			// TODO: replace with a Validation function specific to this field
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+`, segment.SchemaName))
+	}
+	if g.Model.HasLocation {
+		arguments = append(arguments, "\n\t\t\"location\": location.Schema(),\n")
+	}
+	for _, field := range g.fields() {
+		if field.ReadOnly {
+			continue
+		}
+		arguments = append(arguments, field.schemaCode())
+	}
+	if g.Model.HasTags {
+		arguments = append(arguments, "\n\t\t\"tags\": tags.Schema(),\n")
+	}
+
+	return fmt.Sprintf(`func (r %[1]s) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{%[2]s}
+}
+`, g.resourceTypeName(), codeForSchemaEntries(arguments))
+}
+
+func (g resourceGenerator) codeForAttributes() string {
+	attributes := make([]string, 0)
+	for _, field := range g.fields() {
+		if !field.ReadOnly {
+			continue
+		}
+		attributes = append(attributes, field.schemaCode())
+	}
+
+	return fmt.Sprintf(`func (r %[1]s) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{%[2]s}
+}
+`, g.resourceTypeName(), codeForSchemaEntries(attributes))
+}
+
+// codeForSchemaEntries returns the specified Schema entries, separated by a blank line
+func codeForSchemaEntries(entries []string) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	output := make([]string, 0)
+	for _, entry := range entries {
+		output = append(output, strings.TrimSpace(entry))
+	}
+	return fmt.Sprintf("\n%s\n", strings.Join(output, "\n\n"))
+}
+
+// codeForCreateOrUpdate returns the code used to send the SDK Model to Azure, which waits for the
+// operation to complete where it's a long running operation
+func (g resourceGenerator) codeForCreateOrUpdate(operation, operationNoun string) string {
+	if !g.Model.CreateIsLongRunning {
+		return fmt.Sprintf(`			if _, err := client.%[1]s(ctx, %[2]s, parameters); err != nil {
+				return fmt.Errorf("%[3]s %%s: %%+v", id, err)
+			}
+`, g.Model.CreateMethodName, g.ResourceId.sdkArguments("id"), operation)
+	}
+
+	return fmt.Sprintf(`			future, err := client.%[1]s(ctx, %[2]s, parameters)
+			if err != nil {
+				return fmt.Errorf("%[3]s %%s: %%+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for %[4]s of %%s: %%+v", id, err)
+			}
+`, g.Model.CreateMethodName, g.ResourceId.sdkArguments("id"), operation, operationNoun)
+}
+
+func (g resourceGenerator) codeForCreate() string {
+	return fmt.Sprintf(`func (r %[1]s) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%[2]s
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model %[3]s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+			id := parse.New%[4]sID(%[5]s)
+			existing, err := client.Get(ctx, %[6]s)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %%s: %%+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := expand%[3]s(model)
+%[7]s
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+`, g.resourceTypeName(), g.ClientName, g.modelTypeName(), g.ResourceId.TypeName, g.ResourceId.constructorArguments("model"), g.ResourceId.sdkArguments("id"), g.codeForCreateOrUpdate("creating", "creation"))
+}
+
+func (g resourceGenerator) codeForRead() string {
+	return fmt.Sprintf(`func (r %[1]s) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%[2]s
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, %[4]s)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone()
+				}
+
+				return fmt.Errorf("retrieving %%s: %%+v", *id, err)
+			}
+
+			model := flatten%[5]s(*id, resp)
+			return metadata.Encode(&model)
+		},
+	}
+}
+`, g.resourceTypeName(), g.ClientName, g.ResourceId.TypeName, g.ResourceId.sdkArguments("id"), g.modelTypeName())
+}
+
+func (g resourceGenerator) codeForUpdate() string {
+	if !g.updatable() {
+		return ""
+	}
+
+	return fmt.Sprintf(`func (r %[1]s) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%[2]s
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model %[4]s
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %%+v", err)
+			}
+
+			parameters := expand%[4]s(model)
+%[5]s
+			return nil
+		},
+	}
+}
+`, g.resourceTypeName(), g.ClientName, g.ResourceId.TypeName, g.modelTypeName(), g.codeForCreateOrUpdate("updating", "update"))
+}
+
+func (g resourceGenerator) codeForDelete() string {
+	deleteCode := fmt.Sprintf(`			if _, err := client.Delete(ctx, %[1]s); err != nil {
+				return fmt.Errorf("deleting %%s: %%+v", *id, err)
+			}
+`, g.ResourceId.sdkArguments("id"))
+	if g.Model.DeleteIsLongRunning {
+		deleteCode = fmt.Sprintf(`			future, err := client.Delete(ctx, %[1]s)
+			if err != nil {
+				return fmt.Errorf("deleting %%s: %%+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %%s: %%+v", *id, err)
+			}
+`, g.ResourceId.sdkArguments("id"))
+	}
+
+	return fmt.Sprintf(`func (r %[1]s) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.%[2]s
+			id, err := parse.%[3]sID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+%[4]s
+			return nil
+		},
+	}
+}
+`, g.resourceTypeName(), g.ClientName, g.ResourceId.TypeName, deleteCode)
+}
+
+func (g resourceGenerator) codeForIDValidation() string {
+	return fmt.Sprintf(`func (r %[1]s) IDValidationFunc() schema.SchemaValidateFunc {
+	return validate.%[2]sID
+}
+
+func (r %[1]s) IDNormalizationFunc() sdk.IDNormalizationFunc {
+	return parse.Normalize%[2]sID
+}
+`, g.resourceTypeName(), g.ResourceId.TypeName)
+}
+
+func (g resourceGenerator) codeForExpand() string {
+	fields := make([]string, 0)
+	if g.Model.HasLocation {
+		fields = append(fields, "\t\tLocation: utils.String(location.Normalize(input.Location)),\n")
+	}
+	if g.Model.PropertiesTypeName != "" {
+		properties := make([]string, 0)
+		for _, field := range g.fields() {
+			if field.ReadOnly {
+				continue
+			}
+			properties = append(properties, field.expandCode("input"))
+		}
+		fields = append(fields, fmt.Sprintf("\t\t%s: &%s.%s{\n%s\t\t},\n", g.Model.PropertiesFieldName, g.Model.PackageName, g.Model.PropertiesTypeName, strings.Join(properties, "")))
+	}
+	if g.Model.HasTags {
+		fields = append(fields, "\t\tTags: tags.FromTypedObject(input.Tags),\n")
+	}
+
+	return fmt.Sprintf(`func expand%[1]s(input %[1]s) %[2]s.%[3]s {
+	return %[2]s.%[3]s{
+%[4]s	}
+}
+`, g.modelTypeName(), g.Model.PackageName, g.Model.Name, strings.Join(fields, ""))
+}
+
+func (g resourceGenerator) codeForFlatten() string {
+	fields := make([]string, 0)
+	for _, segment := range g.ResourceId.schemaSegments() {
+		fields = append(fields, fmt.Sprintf("\t\t%s: id.%s,\n", segment.ModelFieldName, segment.FieldName))
+	}
+	if g.Model.HasLocation {
+		fields = append(fields, "\t\tLocation: location.NormalizeNilable(input.Location),\n")
+	}
+	if g.Model.HasTags {
+		fields = append(fields, "\t\tTags: tags.ToTypedObject(input.Tags),\n")
+	}
+
+	properties := ""
+	if g.Model.PropertiesTypeName != "" {
+		assignments := make([]string, 0)
+		for _, field := range g.fields() {
+			assignments = append(assignments, field.flattenCode("props", "output"))
+		}
+		properties = fmt.Sprintf(`
+	if props := input.%[1]s; props != nil {
+%[2]s	}
+`, g.Model.PropertiesFieldName, strings.Join(assignments, ""))
+	}
+
+	return fmt.Sprintf(`func flatten%[1]s(id parse.%[2]sId, input %[3]s.%[4]s) %[1]s {
+	output := %[1]s{
+%[5]s	}
+%[6]s
+	return output
+}
+`, g.modelTypeName(), g.ResourceId.TypeName, g.Model.PackageName, g.Model.Name, strings.Join(fields, ""), properties)
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type hclAttribute struct {
+	key   string
+	value string
+}
+
+// codeForHclAttributes returns the specified attributes, with the `=` aligned as `terraform fmt` would
+func codeForHclAttributes(attributes []hclAttribute) string {
+	length := 0
+	for _, attribute := range attributes {
+		if len(attribute.key) > length {
+			length = len(attribute.key)
+		}
+	}
+
+	lines := make([]string, 0)
+	for _, attribute := range attributes {
+		lines = append(lines, fmt.Sprintf("  %-*s = %s", length, attribute.key, attribute.value))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (g resourceGenerator) testTypeName() string {
+	return fmt.Sprintf("%sResource", g.ResourceId.TypeName)
+}
+
+func (g resourceGenerator) TestCode() string {
+	imports := []string{
+		"context",
+		"fmt",
+		"testing",
+		"github.com/hashicorp/terraform-plugin-sdk/helper/resource",
+		"github.com/hashicorp/terraform-plugin-sdk/terraform",
+		fmt.Sprintf("%s/internal/acceptance", providerImportPath),
+		fmt.Sprintf("%s/internal/acceptance/check", providerImportPath),
+		fmt.Sprintf("%s/internal/clients", providerImportPath),
+		fmt.Sprintf("%s/internal/services/%s/parse", providerImportPath, g.ServicePackageName),
+		fmt.Sprintf("%s/utils", providerImportPath),
+	}
+
+	return fmt.Sprintf(`package %[1]s_test
+
+%[2]s
+
+type %[3]s struct{}
+
+%[4]s
+%[5]s
+%[6]s
+%[7]s
+%[8]s
+%[9]s
+`, g.ServicePackageName, codeForImportBlock(imports), g.testTypeName(), g.codeForTests(), g.codeForExists(), g.codeForBasicConfig(), g.codeForRequiresImportConfig(), g.codeForCompleteConfig(), g.codeForTemplateConfig())
+}
+
+func (g resourceGenerator) codeForTests() string {
+	testName := fmt.Sprintf("TestAcc%s", g.ResourceId.TypeName)
+	importStep := "data.ImportStep()"
+	output := fmt.Sprintf(`func %[1]s_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[2]q, "test")
+	r := %[3]s{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%[4]s,
+	})
+}
+
+func %[1]s_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[2]q, "test")
+	r := %[3]s{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func %[1]s_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[2]q, "test")
+	r := %[3]s{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%[4]s,
+	})
+}
+`, testName, g.ResourceName, g.testTypeName(), importStep)
+
+	if !g.updatable() {
+		return output
+	}
+
+	return output + fmt.Sprintf(`
+func %[1]s_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, %[2]q, "test")
+	r := %[3]s{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%[4]s,
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%[4]s,
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		%[4]s,
+	})
+}
+`, testName, g.ResourceName, g.testTypeName(), importStep)
+}
+
+func (g resourceGenerator) codeForExists() string {
+	return fmt.Sprintf(`func (r %[1]s) Exists(ctx context.Context, clients *clients.Client, state *terraform.InstanceState) (*bool, error) {
+	id, err := parse.%[2]sID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.%[3]s.Get(ctx, %[4]s)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %%s: %%+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+`, g.testTypeName(), g.ResourceId.TypeName, g.ClientName, g.ResourceId.sdkArguments("id"))
+}
+
+// requiredHclAttributes returns the attributes required to provision this Resource
+func (g resourceGenerator) requiredHclAttributes() []hclAttribute {
+	attributes := make([]hclAttribute, 0)
+	for _, segment := range g.ResourceId.schemaSegments() {
+		switch segment.SchemaName {
+		case "name":
+			attributes = append(attributes, hclAttribute{key: "name", value: `"acctest-%[2]d"`})
+		case "resource_group_name":
+			attributes = append(attributes, hclAttribute{key: "resource_group_name", value: "azurerm_resource_group.test.name"})
+		default:
+			attributes = append(attributes, hclAttribute{key: segment.SchemaName, value: `"TODO"`})
+		}
+	}
+	if g.Model.HasLocation {
+		attributes = append(attributes, hclAttribute{key: "location", value: "azurerm_resource_group.test.location"})
+	}
+	return attributes
+}
+
+func (g resourceGenerator) codeForBasicConfig() string {
+	return fmt.Sprintf("func (r %[1]s) basic(data acceptance.TestData) string {\n\treturn fmt.Sprintf(`\n%%[1]s\n\nresource %[2]q \"test\" {\n%[3]s\n}\n`, r.template(data), data.RandomInteger)\n}\n",
+		g.testTypeName(), g.ResourceName, codeForHclAttributes(g.requiredHclAttributes()))
+}
+
+func (g resourceGenerator) codeForRequiresImportConfig() string {
+	attributes := make([]hclAttribute, 0)
+	for _, attribute := range g.requiredHclAttributes() {
+		attributes = append(attributes, hclAttribute{
+			key:   attribute.key,
+			value: fmt.Sprintf("%s.test.%s", g.ResourceName, attribute.key),
+		})
+	}
+
+	return fmt.Sprintf("func (r %[1]s) requiresImport(data acceptance.TestData) string {\n\treturn fmt.Sprintf(`\n%%s\n\nresource %[2]q \"import\" {\n%[3]s\n}\n`, r.basic(data))\n}\n",
+		g.testTypeName(), g.ResourceName, codeForHclAttributes(attributes))
+}
+
+func (g resourceGenerator) codeForCompleteConfig() string {
+	attributes := g.requiredHclAttributes()
+	for _, field := range g.fields() {
+		if !field.Supported || field.ReadOnly {
+			continue
+		}
+
+		// TODO values are output since suitable values for each field can't be determined from the SDK
+		value := `"TODO"`
+		switch field.GoType {
+		case "bool":
+			value = "true"
+		case "int64", "float64":
+			value = "1"
+		case "[]string":
+			value = `["TODO"]`
+		}
+		attributes = append(attributes, hclAttribute{key: field.SchemaName, value: value})
+	}
+
+	tagsBlock := ""
+	if g.Model.HasTags {
+		tagsBlock = "\n\n  tags = {\n    ENV = \"Test\"\n  }"
+	}
+
+	return fmt.Sprintf("func (r %[1]s) complete(data acceptance.TestData) string {\n\treturn fmt.Sprintf(`\n%%[1]s\n\nresource %[2]q \"test\" {\n%[3]s%[4]s\n}\n`, r.template(data), data.RandomInteger)\n}\n",
+		g.testTypeName(), g.ResourceName, codeForHclAttributes(attributes), tagsBlock)
+}
+
+func (g resourceGenerator) codeForTemplateConfig() string {
+	return fmt.Sprintf("func (r %[1]s) template(data acceptance.TestData) string {\n\treturn fmt.Sprintf(`\nprovider \"azurerm\" {\n  features {}\n}\n\nresource \"azurerm_resource_group\" \"test\" {\n  name     = \"acctestRG-%%[1]d\"\n  location = \"%%[2]s\"\n}\n\n# TODO: add any dependencies required for this Resource\n`, data.RandomInteger, data.Locations.Primary)\n}\n",
+		g.testTypeName())
+}
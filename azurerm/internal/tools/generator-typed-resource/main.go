@@ -0,0 +1,221 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+func main() {
+	servicePackagePath := flag.String("path", "", "The relative path to the service package")
+	name := flag.String("name", "", "The name of the Resource ID Type for this Resource, e.g. `EventHubConsumerGroup`")
+	resourceName := flag.String("resource-name", "", "The name of this Resource, e.g. `azurerm_eventhub_consumer_group`")
+	id := flag.String("id", "", "An example of the Resource ID for this Resource")
+	sdkPackagePath := flag.String("sdk-package", "", "The import path of the SDK Package containing the Model")
+	modelName := flag.String("model", "", "The name of the Model within the SDK Package, e.g. `ConsumerGroup`")
+	clientName := flag.String("client", "", "The path to the SDK Client within the Clients struct, e.g. `Eventhub.ConsumerGroupClient`")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	input := generatorInput{
+		ServicePackagePath: *servicePackagePath,
+		TypeName:           *name,
+		ResourceName:       *resourceName,
+		ResourceId:         *id,
+		SdkPackagePath:     *sdkPackagePath,
+		ModelName:          *modelName,
+		ClientName:         *clientName,
+	}
+	if err := run(input); err != nil {
+		fmt.Fprintf(os.Stderr, "%+v\n", err)
+		os.Exit(1)
+	}
+}
+
+type generatorInput struct {
+	ServicePackagePath string
+	TypeName           string
+	ResourceName       string
+	ResourceId         string
+	SdkPackagePath     string
+	ModelName          string
+	ClientName         string
+}
+
+func (input generatorInput) validate() error {
+	values := map[string]string{
+		"path":          input.ServicePackagePath,
+		"name":          input.TypeName,
+		"resource-name": input.ResourceName,
+		"id":            input.ResourceId,
+		"sdk-package":   input.SdkPackagePath,
+		"model":         input.ModelName,
+		"client":        input.ClientName,
+	}
+	for _, key := range []string{"path", "name", "resource-name", "id", "sdk-package", "model", "client"} {
+		if values[key] == "" {
+			return fmt.Errorf("`-%s` must be specified", key)
+		}
+	}
+
+	if !strings.HasPrefix(input.ResourceName, "azurerm_") {
+		return fmt.Errorf("`-resource-name` must be prefixed with `azurerm_` but got %q", input.ResourceName)
+	}
+
+	return nil
+}
+
+func run(input generatorInput) error {
+	if err := input.validate(); err != nil {
+		return err
+	}
+
+	servicePackage, err := parseServicePackageName(input.ServicePackagePath)
+	if err != nil {
+		return fmt.Errorf("determining Service Package Name for %q: %+v", input.ServicePackagePath, err)
+	}
+
+	resourceId, err := parseResourceId(input.TypeName, input.ResourceId)
+	if err != nil {
+		return fmt.Errorf("parsing the Resource ID: %+v", err)
+	}
+
+	sdkDirectory, err := sdkPackageDirectory(input.SdkPackagePath)
+	if err != nil {
+		return err
+	}
+
+	model, err := parseSdkModel(input.SdkPackagePath, *sdkDirectory, input.ModelName)
+	if err != nil {
+		return err
+	}
+
+	generator := resourceGenerator{
+		ServicePackageName: *servicePackage,
+		ResourceName:       input.ResourceName,
+		ClientName:         input.ClientName,
+		ResourceId:         *resourceId,
+		Model:              *model,
+	}
+
+	fileName := strings.TrimPrefix(input.ResourceName, "azurerm_")
+	resourceFilePath := path.Join(input.ServicePackagePath, fmt.Sprintf("%s_resource.go", fileName))
+	if err := goFmtAndWriteToFile(resourceFilePath, generator.Code()); err != nil {
+		return fmt.Errorf("generating Resource at %q: %+v", resourceFilePath, err)
+	}
+
+	testFilePath := path.Join(input.ServicePackagePath, fmt.Sprintf("%s_resource_test.go", fileName))
+	if err := goFmtAndWriteToFile(testFilePath, generator.TestCode()); err != nil {
+		return fmt.Errorf("generating Acceptance Tests at %q: %+v", testFilePath, err)
+	}
+
+	fmt.Printf("Generated %q and %q\n\n", resourceFilePath, testFilePath)
+	fmt.Printf("Next Steps:\n")
+	fmt.Printf("* Register `%sResource{}` within the `Resources()` function in the `registration.go` file of the Service Package\n", input.TypeName)
+	if _, err := os.Stat(path.Join(input.ServicePackagePath, "parse", fmt.Sprintf("%s.go", convertToSnakeCase(input.TypeName)))); os.IsNotExist(err) {
+		fmt.Printf("* Generate the Resource ID using `generator-resource-id` by adding the following to `resourceids.go` in the Service Package:\n")
+		fmt.Printf("  //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=%s -id=%s\n", input.TypeName, input.ResourceId)
+	}
+	fmt.Printf("* Review each of the TODOs within the generated files\n")
+
+	return nil
+}
+
+func parseServicePackageName(relativePath string) (*string, error) {
+	path := relativePath
+	if !filepath.IsAbs(path) {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+
+		path = abs
+	}
+
+	// we do this replacement to avoid the case that on windows machine, the absolute path are using the path separator of \ instead of /
+	path = strings.ReplaceAll(path, "\\", "/")
+	segments := strings.Split(path, "/")
+	serviceIndex := -1
+	for i, v := range segments {
+		if strings.EqualFold(v, "services") {
+			serviceIndex = i
+			break
+		}
+	}
+
+	if serviceIndex == -1 {
+		return nil, fmt.Errorf("`services` segment was not found")
+	}
+
+	if len(segments) <= serviceIndex+1 {
+		return nil, fmt.Errorf("not enough segments")
+	}
+
+	servicePackageName := segments[serviceIndex+1]
+	return &servicePackageName, nil
+}
+
+func convertToSnakeCase(input string) string {
+	splitIdxMap := map[int]struct{}{}
+	var lastChar rune
+	for idx, char := range input {
+		switch {
+		case idx == 0:
+			splitIdxMap[idx] = struct{}{}
+		case unicode.IsUpper(lastChar) == unicode.IsUpper(char):
+		case unicode.IsUpper(lastChar):
+			splitIdxMap[idx-1] = struct{}{}
+		case unicode.IsUpper(char):
+			splitIdxMap[idx] = struct{}{}
+		}
+		lastChar = char
+	}
+	splitIdx := make([]int, 0, len(splitIdxMap))
+	for idx := range splitIdxMap {
+		splitIdx = append(splitIdx, idx)
+	}
+	sort.Ints(splitIdx)
+
+	inputRunes := []rune(input)
+	out := make([]string, len(splitIdx))
+	for i := range splitIdx {
+		if i == len(splitIdx)-1 {
+			out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:]))
+			continue
+		}
+		out[i] = strings.ToLower(string(inputRunes[splitIdx[i]:splitIdx[i+1]]))
+	}
+	return strings.Join(out, "_")
+}
+
+// goFmtAndWriteToFile formats the generated code and writes it to the specified file - existing
+// files aren't overwritten since these are likely to contain changes made by hand
+func goFmtAndWriteToFile(filePath, fileContents string) error {
+	if _, err := os.Stat(filePath); err == nil {
+		return fmt.Errorf("the file %q already exists", filePath)
+	}
+
+	formatted, err := format.Source([]byte(fileContents))
+	if err != nil {
+		return fmt.Errorf("formatting the generated code: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(filePath, formatted, 0644); err != nil {
+		return err
+	}
+
+	return nil
+}
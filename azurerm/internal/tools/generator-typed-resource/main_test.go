@@ -0,0 +1,244 @@
+package main
+
+import (
+	"go/format"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSdkPackage = `package widgets
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+type SkuName string
+
+const (
+	Basic    SkuName = "Basic"
+	Standard SkuName = "Standard"
+)
+
+type Widget struct {
+	autorest.Response ` + "`json:\"-\"`" + `
+	*WidgetProperties ` + "`json:\"properties,omitempty\"`" + `
+	Location *string ` + "`json:\"location,omitempty\"`" + `
+	Tags map[string]*string ` + "`json:\"tags\"`" + `
+	ID *string ` + "`json:\"id,omitempty\"`" + `
+}
+
+type WidgetProperties struct {
+	Description *string ` + "`json:\"description,omitempty\"`" + `
+	Enabled *bool ` + "`json:\"enabled,omitempty\"`" + `
+	Capacity *int32 ` + "`json:\"capacity,omitempty\"`" + `
+	Sku SkuName ` + "`json:\"sku,omitempty\"`" + `
+	AllowedIPs *[]string ` + "`json:\"allowedIps,omitempty\"`" + `
+	// CreatedAt - READ-ONLY; when this Widget was created
+	CreatedAt *date.Time ` + "`json:\"createdAt,omitempty\"`" + `
+	// Fqdn - READ-ONLY; the FQDN for this Widget
+	Fqdn *string ` + "`json:\"fqdn,omitempty\"`" + `
+}
+
+type WidgetsCreateOrUpdateFuture struct{}
+
+type WidgetsDeleteFuture struct{}
+
+type WidgetsClient struct{}
+
+func (client WidgetsClient) CreateOrUpdate(ctx context.Context, resourceGroupName string, factoryName string, widgetName string, parameters Widget) (result WidgetsCreateOrUpdateFuture, err error) {
+	return
+}
+
+func (client WidgetsClient) Delete(ctx context.Context, resourceGroupName string, factoryName string, widgetName string) (result WidgetsDeleteFuture, err error) {
+	return
+}
+
+func (client WidgetsClient) Get(ctx context.Context, resourceGroupName string, factoryName string, widgetName string) (result Widget, err error) {
+	return
+}
+`
+
+const testResourceId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Example/factories/factory1/widgets/widget1"
+
+func testModel(t *testing.T) *sdkModel {
+	directory := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(directory, "models.go"), []byte(testSdkPackage), 0644); err != nil {
+		t.Fatalf("writing SDK Package: %+v", err)
+	}
+
+	model, err := parseSdkModel("github.com/Azure/azure-sdk-for-go/services/example/mgmt/2021-01-01/widgets", directory, "Widget")
+	if err != nil {
+		t.Fatalf("parsing SDK Model: %+v", err)
+	}
+
+	return model
+}
+
+func TestParseResourceId(t *testing.T) {
+	testData := []struct {
+		TypeName string
+		Input    string
+		Expected []resourceIdSegment
+		Error    bool
+	}{
+		{
+			// subscription scoped resources aren't supported at this time
+			TypeName: "Widget",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Example/widgets/widget1",
+			Error:    true,
+		},
+		{
+			// missing segment after the resource group
+			TypeName: "ResourceGroup",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Error:    true,
+		},
+		{
+			TypeName: "Widget",
+			Input:    testResourceId,
+			Expected: []resourceIdSegment{
+				{FieldName: "ResourceGroup", ModelFieldName: "ResourceGroupName", SchemaName: "resource_group_name", SegmentKey: "resourceGroups"},
+				{FieldName: "FactoryName", ModelFieldName: "FactoryName", SchemaName: "factory_name", SegmentKey: "factories"},
+				{FieldName: "Name", ModelFieldName: "Name", SchemaName: "name", SegmentKey: "widgets"},
+			},
+		},
+		{
+			TypeName: "EventHubConsumerGroup",
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/group1",
+			Expected: []resourceIdSegment{
+				{FieldName: "ResourceGroup", ModelFieldName: "ResourceGroupName", SchemaName: "resource_group_name", SegmentKey: "resourceGroups"},
+				{FieldName: "NamespaceName", ModelFieldName: "NamespaceName", SchemaName: "namespace_name", SegmentKey: "namespaces"},
+				{FieldName: "EventhubName", ModelFieldName: "EventhubName", SchemaName: "eventhub_name", SegmentKey: "eventhubs"},
+				{FieldName: "ConsumergroupName", ModelFieldName: "Name", SchemaName: "name", SegmentKey: "consumergroups"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseResourceId(v.TypeName, v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if len(actual.Segments) != len(v.Expected) {
+			t.Fatalf("Expected %d segments but got %d", len(v.Expected), len(actual.Segments))
+		}
+		for i, segment := range actual.Segments {
+			if segment != v.Expected[i] {
+				t.Fatalf("Expected segment %d to be %+v but got %+v", i, v.Expected[i], segment)
+			}
+		}
+	}
+}
+
+func TestParseSdkModel(t *testing.T) {
+	model := testModel(t)
+
+	if model.PackageName != "widgets" {
+		t.Fatalf("Expected the Package Name to be %q but got %q", "widgets", model.PackageName)
+	}
+	if model.PropertiesFieldName != "WidgetProperties" {
+		t.Fatalf("Expected the Properties Field Name to be %q but got %q", "WidgetProperties", model.PropertiesFieldName)
+	}
+	if !model.HasLocation || !model.HasTags {
+		t.Fatalf("Expected the Model to have a Location and Tags")
+	}
+	if model.ClientTypeName != "WidgetsClient" || model.CreateMethodName != "CreateOrUpdate" {
+		t.Fatalf("Expected the Client to be `WidgetsClient.CreateOrUpdate` but got `%s.%s`", model.ClientTypeName, model.CreateMethodName)
+	}
+	if !model.CreateIsLongRunning || !model.DeleteIsLongRunning {
+		t.Fatalf("Expected Create and Delete to be Long Running")
+	}
+
+	expected := map[string]sdkField{
+		"Description": {Name: "Description", JsonName: "description", Type: "*string"},
+		"Enabled":     {Name: "Enabled", JsonName: "enabled", Type: "*bool"},
+		"Capacity":    {Name: "Capacity", JsonName: "capacity", Type: "*int32"},
+		"Sku":         {Name: "Sku", JsonName: "sku", Type: "SkuName", EnumValues: []string{"Basic", "Standard"}},
+		"AllowedIPs":  {Name: "AllowedIPs", JsonName: "allowedIps", Type: "*[]string"},
+		"CreatedAt":   {Name: "CreatedAt", JsonName: "createdAt", Type: "*date.Time", ReadOnly: true},
+		"Fqdn":        {Name: "Fqdn", JsonName: "fqdn", Type: "*string", ReadOnly: true},
+	}
+	if len(model.Fields) != len(expected) {
+		t.Fatalf("Expected %d fields but got %d", len(expected), len(model.Fields))
+	}
+	for _, field := range model.Fields {
+		v, ok := expected[field.Name]
+		if !ok {
+			t.Fatalf("Unexpected field %q", field.Name)
+		}
+		if field.JsonName != v.JsonName || field.Type != v.Type || field.ReadOnly != v.ReadOnly || strings.Join(field.EnumValues, ",") != strings.Join(v.EnumValues, ",") {
+			t.Fatalf("Expected the field %q to be %+v but got %+v", field.Name, v, field)
+		}
+	}
+}
+
+func TestGeneratedCode(t *testing.T) {
+	resourceId, err := parseResourceId("Widget", testResourceId)
+	if err != nil {
+		t.Fatalf("parsing Resource ID: %+v", err)
+	}
+
+	generator := resourceGenerator{
+		ServicePackageName: "example",
+		ResourceName:       "azurerm_example_widget",
+		ClientName:         "Example.WidgetsClient",
+		ResourceId:         *resourceId,
+		Model:              *testModel(t),
+	}
+
+	code, err := format.Source([]byte(generator.Code()))
+	if err != nil {
+		t.Fatalf("formatting the Resource: %+v\n\n%s", err, generator.Code())
+	}
+	expected := []string{
+		"var _ sdk.ResourceWithUpdate = WidgetResource{}",
+		"Name              string            `tfschema:\"name\"`",
+		"AllowedIPs        []string          `tfschema:\"allowed_ips\"`",
+		"// TODO: map the field \"CreatedAt\"",
+		"\"fqdn\": {\n\t\t\tType:     schema.TypeString,\n\t\t\tComputed: true,",
+		"string(widgets.Basic),",
+		"id := parse.NewWidgetID(subscriptionId, model.ResourceGroupName, model.FactoryName, model.Name)",
+		"client.CreateOrUpdate(ctx, id.ResourceGroup, id.FactoryName, id.Name, parameters)",
+		"future.WaitForCompletionRef(ctx, client.Client)",
+		"Capacity:    utils.Int32(int32(input.Capacity)),",
+		"output.Capacity = int64(*props.Capacity)",
+		"return validate.WidgetID",
+		"return parse.NormalizeWidgetID",
+	}
+	for _, v := range expected {
+		if !strings.Contains(string(code), v) {
+			t.Fatalf("Expected the Resource to contain %q:\n\n%s", v, string(code))
+		}
+	}
+
+	testCode, err := format.Source([]byte(generator.TestCode()))
+	if err != nil {
+		t.Fatalf("formatting the Acceptance Tests: %+v\n\n%s", err, generator.TestCode())
+	}
+	expected = []string{
+		"package example_test",
+		"func TestAccWidget_update(t *testing.T) {",
+		"resp, err := clients.Example.WidgetsClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name)",
+		"  name                = \"acctest-%[2]d\"\n  resource_group_name = azurerm_resource_group.test.name\n  factory_name        = \"TODO\"",
+	}
+	for _, v := range expected {
+		if !strings.Contains(string(testCode), v) {
+			t.Fatalf("Expected the Acceptance Tests to contain %q:\n\n%s", v, string(testCode))
+		}
+	}
+}
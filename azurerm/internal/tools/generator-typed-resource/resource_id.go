@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceIdSegment is a user-specifiable segment of the Resource ID
+//
+// NOTE: the names of the fields need to match those generated by `generator-resource-id`
+type resourceIdSegment struct {
+	// FieldName is the name of the field for this segment in the Resource ID Struct, e.g. `ResourceGroup`
+	FieldName string
+
+	// ModelFieldName is the name of the field for this segment in the Model, e.g. `ResourceGroupName`
+	ModelFieldName string
+
+	// SchemaName is the name of the argument for this segment in the Schema, e.g. `resource_group_name`
+	SchemaName string
+
+	// SegmentKey is the Segment used for this in the Resource ID e.g. `resourceGroups`
+	SegmentKey string
+}
+
+type resourceId struct {
+	// TypeName is the name of the Resource ID Type, e.g. `EventHubConsumerGroup`
+	TypeName string
+
+	// Segments are the segments in the Resource ID, other than the Subscription ID, in the order
+	// these are defined in the Resource ID (and as such, passed to the SDK)
+	Segments []resourceIdSegment
+}
+
+func parseResourceId(typeName, input string) (*resourceId, error) {
+	// split the string, but remove the prefix of `/` since it's an empty segment
+	split := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(split)%2 != 0 {
+		return nil, fmt.Errorf("segments weren't divisible by 2: %q", input)
+	}
+
+	hasSubscriptionId := false
+	hasResourceGroup := false
+	segments := make([]resourceIdSegment, 0)
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+
+		// the RP isn't user-specifiable
+		if key == "providers" {
+			continue
+		}
+
+		if key == "subscriptions" && !hasSubscriptionId {
+			// the Subscription ID comes from the Provider rather than the Schema
+			hasSubscriptionId = true
+			continue
+		}
+
+		if strings.EqualFold(key, "resourceGroups") {
+			hasResourceGroup = true
+			segments = append(segments, resourceIdSegment{
+				FieldName:      "ResourceGroup",
+				ModelFieldName: "ResourceGroupName",
+				SchemaName:     "resource_group_name",
+				SegmentKey:     key,
+			})
+			continue
+		}
+
+		segments = append(segments, segmentForKey(typeName, key))
+	}
+
+	if !hasSubscriptionId || !hasResourceGroup {
+		return nil, fmt.Errorf("only Resource IDs within a Resource Group are supported at this time: %q", input)
+	}
+
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("expected the Resource ID to contain at least one segment after the Resource Group: %q", input)
+	}
+
+	// the last segment is the name of this resource
+	segments[len(segments)-1].ModelFieldName = "Name"
+	segments[len(segments)-1].SchemaName = "name"
+
+	return &resourceId{
+		TypeName: typeName,
+		Segments: segments,
+	}, nil
+}
+
+func segmentForKey(typeName, key string) resourceIdSegment {
+	singular := key
+	if strings.HasSuffix(key, "s") {
+		switch {
+		case strings.HasSuffix(key, "ies"):
+			// handles "GallerieName" and `DataFactoriesName`
+			singular = fmt.Sprintf("%sy", strings.TrimSuffix(key, "ies"))
+		case strings.HasSuffix(key, "sses"):
+			// handles `PublicIPAddressesName`
+			singular = fmt.Sprintf("%sss", strings.TrimSuffix(key, "sses"))
+		default:
+			singular = strings.TrimSuffix(key, "s")
+		}
+	}
+
+	fieldName := strings.Title(fmt.Sprintf("%sName", singular))
+	if singular != key && strings.EqualFold(singular, typeName) {
+		fieldName = "Name"
+	}
+
+	return resourceIdSegment{
+		FieldName:      fieldName,
+		ModelFieldName: fieldName,
+		SchemaName:     fmt.Sprintf("%s_name", convertToSnakeCase(singular)),
+		SegmentKey:     key,
+	}
+}
+
+// schemaSegments returns the segments in the order they're defined in the Schema, which is the `name`
+// followed by the `resource_group_name` and then any parent resources
+func (id resourceId) schemaSegments() []resourceIdSegment {
+	last := len(id.Segments) - 1
+	output := []resourceIdSegment{id.Segments[last]}
+	return append(output, id.Segments[:last]...)
+}
+
+// sdkArguments returns the arguments passed to the SDK methods for this Resource ID, e.g. `id.ResourceGroup, id.Name`
+func (id resourceId) sdkArguments(variableName string) string {
+	arguments := make([]string, 0)
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%s.%s", variableName, segment.FieldName))
+	}
+	return strings.Join(arguments, ", ")
+}
+
+// constructorArguments returns the arguments passed to the Resource ID constructor, using the values from the Model
+func (id resourceId) constructorArguments(modelVariableName string) string {
+	arguments := []string{"subscriptionId"}
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%s.%s", modelVariableName, segment.ModelFieldName))
+	}
+	return strings.Join(arguments, ", ")
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// sdkField is a field within the Properties of the SDK Model
+type sdkField struct {
+	// Name is the name of this field within the SDK Model, e.g. `UserMetadata`
+	Name string
+
+	// JsonName is the name of this field within the API, e.g. `userMetadata`
+	JsonName string
+
+	// Type is the Go type of this field, e.g. `*string` or `SkuName`
+	Type string
+
+	// ReadOnly specifies whether this field is returned from the API, but can't be set
+	ReadOnly bool
+
+	// EnumValues are the names of the constants which are possible values for this field, when this is an enum
+	EnumValues []string
+}
+
+// sdkModel is the Model (and associated Client) within the Azure SDK which is used for this Resource
+type sdkModel struct {
+	// PackageName is the name of the SDK Package, e.g. `eventhub`
+	PackageName string
+
+	// PackagePath is the import path of the SDK Package
+	PackagePath string
+
+	// Name is the name of the Model, e.g. `ConsumerGroup`
+	Name string
+
+	// PropertiesFieldName is the name of the field containing the Properties for this Model, e.g. `ConsumerGroupProperties`
+	PropertiesFieldName string
+
+	// PropertiesTypeName is the name of the type of the Properties for this Model, e.g. `ConsumerGroupProperties`
+	PropertiesTypeName string
+
+	// Fields are the fields within the Properties for this Model
+	Fields []sdkField
+
+	// HasLocation specifies whether this Model contains a top-level `Location` field
+	HasLocation bool
+
+	// HasTags specifies whether this Model contains a top-level `Tags` field
+	HasTags bool
+
+	// ClientTypeName is the name of the SDK Client used to manage this Model, e.g. `ConsumerGroupsClient`
+	ClientTypeName string
+
+	// CreateMethodName is the name of the method used to create this Model, e.g. `CreateOrUpdate`
+	CreateMethodName string
+
+	// CreateIsLongRunning specifies whether the create method returns a Future which needs to be polled
+	CreateIsLongRunning bool
+
+	// DeleteIsLongRunning specifies whether the `Delete` method returns a Future which needs to be polled
+	DeleteIsLongRunning bool
+}
+
+// sdkPackageDirectory returns the directory containing the specified SDK Package, which is resolved
+// using `go list` so that the vendored copy of the Azure SDK is used
+func sdkPackageDirectory(packagePath string) (*string, error) {
+	if info, err := os.Stat(packagePath); err == nil && info.IsDir() {
+		return &packagePath, nil
+	}
+
+	output, err := exec.Command("go", "list", "-f", "{{.Dir}}", packagePath).Output()
+	if err != nil {
+		return nil, fmt.Errorf("locating the SDK Package %q: %+v", packagePath, err)
+	}
+
+	directory := strings.TrimSpace(string(output))
+	return &directory, nil
+}
+
+type sdkPackage struct {
+	name      string
+	structs   map[string]*ast.StructType
+	enums     map[string]struct{}
+	constants map[string][]string
+	methods   map[string]map[string]*ast.FuncType
+}
+
+func parseSdkPackage(directory string) (*sdkPackage, error) {
+	fileSet := token.NewFileSet()
+	filter := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}
+	packages, err := parser.ParseDir(fileSet, directory, filter, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the SDK Package at %q: %+v", directory, err)
+	}
+	if len(packages) != 1 {
+		return nil, fmt.Errorf("expected a single Package at %q but got %d", directory, len(packages))
+	}
+
+	output := sdkPackage{
+		structs:   make(map[string]*ast.StructType),
+		enums:     make(map[string]struct{}),
+		constants: make(map[string][]string),
+		methods:   make(map[string]map[string]*ast.FuncType),
+	}
+	for name, pkg := range packages {
+		output.name = name
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch v := decl.(type) {
+				case *ast.GenDecl:
+					output.parseGenDecl(v)
+
+				case *ast.FuncDecl:
+					if v.Recv == nil || len(v.Recv.List) != 1 {
+						continue
+					}
+					receiver := types.ExprString(v.Recv.List[0].Type)
+					if _, ok := output.methods[receiver]; !ok {
+						output.methods[receiver] = make(map[string]*ast.FuncType)
+					}
+					output.methods[receiver][v.Name.Name] = v.Type
+				}
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+func (p *sdkPackage) parseGenDecl(decl *ast.GenDecl) {
+	for _, spec := range decl.Specs {
+		switch v := spec.(type) {
+		case *ast.TypeSpec:
+			if structType, ok := v.Type.(*ast.StructType); ok {
+				p.structs[v.Name.Name] = structType
+			}
+			if ident, ok := v.Type.(*ast.Ident); ok && ident.Name == "string" {
+				p.enums[v.Name.Name] = struct{}{}
+			}
+
+		case *ast.ValueSpec:
+			if decl.Tok != token.CONST || v.Type == nil {
+				continue
+			}
+			typeName := types.ExprString(v.Type)
+			for _, name := range v.Names {
+				p.constants[typeName] = append(p.constants[typeName], name.Name)
+			}
+		}
+	}
+}
+
+func parseSdkModel(packagePath, directory, modelName string) (*sdkModel, error) {
+	pkg, err := parseSdkPackage(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	model, ok := pkg.structs[modelName]
+	if !ok {
+		return nil, fmt.Errorf("the Model %q was not found in the SDK Package %q", modelName, packagePath)
+	}
+
+	output := sdkModel{
+		PackageName: pkg.name,
+		PackagePath: packagePath,
+		Name:        modelName,
+		Fields:      make([]sdkField, 0),
+	}
+
+	for _, field := range model.Fields.List {
+		fieldType := types.ExprString(field.Type)
+
+		// the Properties are either embedded (e.g. `*ConsumerGroupProperties`) or within a `Properties` field
+		if len(field.Names) == 0 || field.Names[0].Name == "Properties" {
+			typeName := strings.TrimPrefix(fieldType, "*")
+			if _, ok := pkg.structs[typeName]; !ok || typeName == fieldType || !isPropertiesType(typeName) {
+				continue
+			}
+
+			output.PropertiesFieldName = typeName
+			if len(field.Names) > 0 {
+				output.PropertiesFieldName = field.Names[0].Name
+			}
+			output.PropertiesTypeName = typeName
+			continue
+		}
+
+		switch field.Names[0].Name {
+		case "Location":
+			output.HasLocation = fieldType == "*string"
+		case "Tags":
+			output.HasTags = fieldType == "map[string]*string"
+		}
+	}
+
+	if output.PropertiesTypeName != "" {
+		for _, field := range pkg.structs[output.PropertiesTypeName].Fields.List {
+			if len(field.Names) == 0 {
+				continue
+			}
+
+			fieldType := types.ExprString(field.Type)
+			readOnly := field.Doc != nil && strings.Contains(field.Doc.Text(), "READ-ONLY")
+			jsonName := ""
+			if field.Tag != nil {
+				tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+				jsonName = strings.Split(tag.Get("json"), ",")[0]
+			}
+			for _, name := range field.Names {
+				if !name.IsExported() {
+					continue
+				}
+
+				sdkField := sdkField{
+					Name:     name.Name,
+					JsonName: jsonName,
+					Type:     fieldType,
+					ReadOnly: readOnly,
+				}
+				if _, ok := pkg.enums[fieldType]; ok {
+					values := pkg.constants[fieldType]
+					sort.Strings(values)
+					sdkField.EnumValues = values
+				}
+				output.Fields = append(output.Fields, sdkField)
+			}
+		}
+	}
+
+	if err := output.findClient(pkg); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// findClient finds the SDK Client used to manage this Model, which is the Client containing a `Create`
+// (or `CreateOrUpdate`) method accepting this Model, alongside a `Get` and `Delete` method
+func (m *sdkModel) findClient(pkg *sdkPackage) error {
+	clientNames := make([]string, 0)
+	for name := range pkg.methods {
+		clientNames = append(clientNames, name)
+	}
+	sort.Strings(clientNames)
+
+	for _, clientName := range clientNames {
+		methods := pkg.methods[clientName]
+		if _, ok := methods["Get"]; !ok {
+			continue
+		}
+		deleteMethod, ok := methods["Delete"]
+		if !ok {
+			continue
+		}
+
+		for _, createMethodName := range []string{"CreateOrUpdate", "Create"} {
+			createMethod, ok := methods[createMethodName]
+			if !ok || !acceptsType(createMethod, m.Name) {
+				continue
+			}
+
+			m.ClientTypeName = clientName
+			m.CreateMethodName = createMethodName
+			m.CreateIsLongRunning = returnsFuture(createMethod)
+			m.DeleteIsLongRunning = returnsFuture(deleteMethod)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("a Client with a `Create` or `CreateOrUpdate` method accepting the Model %q was not found", m.Name)
+}
+
+// isPropertiesType returns whether the specified type contains the Properties for a Model, which
+// is either `{Name}Properties` or `{Name}PropertiesFormat` (as used in the Network API)
+func isPropertiesType(typeName string) bool {
+	return strings.HasSuffix(typeName, "Properties") || strings.HasSuffix(typeName, "PropertiesFormat")
+}
+
+func acceptsType(method *ast.FuncType, typeName string) bool {
+	for _, param := range method.Params.List {
+		if types.ExprString(param.Type) == typeName {
+			return true
+		}
+	}
+
+	return false
+}
+
+func returnsFuture(method *ast.FuncType) bool {
+	if method.Results == nil || len(method.Results.List) == 0 {
+		return false
+	}
+
+	return strings.HasSuffix(types.ExprString(method.Results.List[0].Type), "Future")
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// allowList contains the violations which are allowed for legacy reasons, where each entry is in the format
+// `{rule} {address}` - for example `importer-validation azurerm_example` or `max-items-one-list azurerm_example.block`
+type allowList struct {
+	// entries are the entries in the same order as the Allow List
+	entries []string
+
+	lookup map[string]struct{}
+}
+
+func (l allowList) allows(v violation) bool {
+	_, ok := l.lookup[v.key()]
+	return ok
+}
+
+// parseAllowList parses the Allow List, ignoring empty lines and comments (lines starting with `#`)
+func parseAllowList(lines []string) (*allowList, error) {
+	output := allowList{
+		entries: make([]string, 0),
+		lookup:  make(map[string]struct{}),
+	}
+
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected an entry in the format `{rule} {address}` but got %q", i+1, line)
+		}
+
+		if _, ok := rulesByName()[fields[0]]; !ok {
+			return nil, fmt.Errorf("line %d: the rule %q doesn't exist", i+1, fields[0])
+		}
+
+		entry := strings.Join(fields, " ")
+		if _, exists := output.lookup[entry]; exists {
+			return nil, fmt.Errorf("line %d: the entry %q is duplicated", i+1, entry)
+		}
+
+		output.entries = append(output.entries, entry)
+		output.lookup[entry] = struct{}{}
+	}
+
+	return &output, nil
+}
+
+func parseAllowListFile(path string) (allowList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return allowList{}, fmt.Errorf("opening %q: %+v", path, err)
+	}
+	defer file.Close()
+
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return allowList{}, fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	output, err := parseAllowList(lines)
+	if err != nil {
+		return allowList{}, fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	return *output, nil
+}
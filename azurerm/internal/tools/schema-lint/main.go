@@ -0,0 +1,171 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+)
+
+func main() {
+	f := flag.NewFlagSet("schema-lint", flag.ExitOnError)
+
+	allowListPath := f.String("allowlist", "", "(Optional) The path to the file containing the violations which are allowed for legacy reasons")
+	showRules := f.Bool("rules", false, "Display the rules which are enforced")
+	showHelp := f.Bool("help", false, "Display this message")
+
+	_ = f.Parse(os.Args[1:])
+
+	if *showHelp {
+		f.Usage()
+		return
+	}
+
+	if *showRules {
+		for _, r := range rules {
+			fmt.Printf("%s: %s\n", r.name, r.description)
+		}
+		return
+	}
+
+	allowed := allowList{}
+	if *allowListPath != "" {
+		var err error
+		allowed, err = parseAllowListFile(*allowListPath)
+		if err != nil {
+			log.Printf("parsing the Allow List: %+v", err)
+			os.Exit(1)
+		}
+	}
+
+	targets, err := registeredTargets()
+	if err != nil {
+		log.Printf("retrieving the registered Data Sources and Resources: %+v", err)
+		os.Exit(1)
+	}
+
+	violations, unused := run(targets, allowed)
+	for _, v := range violations {
+		log.Print(v.String())
+	}
+	for _, v := range unused {
+		log.Printf("the Allow List entry %q no longer matches a violation and should be removed", v)
+	}
+
+	if len(violations) > 0 || len(unused) > 0 {
+		log.Printf("Found %d violations and %d unused Allow List entries", len(violations), len(unused))
+		os.Exit(1)
+	}
+}
+
+// target is a Data Source or Resource which is linted
+type target struct {
+	// name is the name of this Data Source/Resource e.g. `azurerm_resource_group`
+	name string
+
+	isDataSource bool
+
+	resource *schema.Resource
+}
+
+// address returns the address used for this Data Source/Resource in the violations and the Allow List,
+// which (as in Terraform) is prefixed with `data.` for Data Sources
+func (t target) address() string {
+	if t.isDataSource {
+		return fmt.Sprintf("data.%s", t.name)
+	}
+
+	return t.name
+}
+
+// registeredTargets returns each of the Data Sources and Resources registered within each Service
+func registeredTargets() ([]target, error) {
+	targets := make([]target, 0)
+
+	for _, service := range provider.SupportedTypedServices() {
+		for _, ds := range service.DataSources() {
+			wrapper := sdk.NewDataSourceWrapper(ds)
+			dataSource, err := wrapper.DataSource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Data Source %q: %+v", ds.ResourceType(), err)
+			}
+
+			targets = append(targets, target{
+				name:         ds.ResourceType(),
+				isDataSource: true,
+				resource:     dataSource,
+			})
+		}
+
+		for _, r := range service.Resources() {
+			wrapper := sdk.NewResourceWrapper(r)
+			resource, err := wrapper.Resource()
+			if err != nil {
+				return nil, fmt.Errorf("wrapping Resource %q: %+v", r.ResourceType(), err)
+			}
+
+			targets = append(targets, target{
+				name:     r.ResourceType(),
+				resource: resource,
+			})
+		}
+	}
+
+	for _, service := range provider.SupportedUntypedServices() {
+		for name, dataSource := range service.SupportedDataSources() {
+			targets = append(targets, target{
+				name:         name,
+				isDataSource: true,
+				resource:     dataSource,
+			})
+		}
+
+		for name, resource := range service.SupportedResources() {
+			targets = append(targets, target{
+				name:     name,
+				resource: resource,
+			})
+		}
+	}
+
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].address() < targets[j].address()
+	})
+
+	return targets, nil
+}
+
+// run lints each of the targets, returning the violations which aren't allowed and
+// any entries in the Allow List which no longer match a violation
+func run(targets []target, allowed allowList) ([]violation, []string) {
+	violations := make([]violation, 0)
+	used := make(map[string]struct{})
+
+	for _, t := range targets {
+		for _, rule := range rules {
+			for _, v := range rule.check(t) {
+				if allowed.allows(v) {
+					used[v.key()] = struct{}{}
+					continue
+				}
+
+				violations = append(violations, v)
+			}
+		}
+	}
+
+	unused := make([]string, 0)
+	for _, entry := range allowed.entries {
+		if _, ok := used[entry]; !ok {
+			unused = append(unused, entry)
+		}
+	}
+	sort.Strings(unused)
+
+	return violations, unused
+}
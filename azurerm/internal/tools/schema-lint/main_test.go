@@ -0,0 +1,305 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
+)
+
+func validResource() *schema.Resource {
+	return &schema.Resource{
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			if !strings.HasPrefix(id, "/subscriptions/") {
+				return fmt.Errorf("expected %q to be a Resource ID", id)
+			}
+			return nil
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": location.Schema(),
+
+			"block": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nested": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func validDataSource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"location": location.SchemaComputed(),
+
+			"tags": tags.SchemaDataSource(),
+		},
+	}
+}
+
+func TestRules(t *testing.T) {
+	testData := []struct {
+		Name         string
+		IsDataSource bool
+		Resource     func() *schema.Resource
+		Expected     []string
+	}{
+		{
+			Name:     "Valid Resource",
+			Resource: validResource,
+			Expected: []string{},
+		},
+		{
+			Name:         "Valid Data Source",
+			IsDataSource: true,
+			Resource:     validDataSource,
+			Expected:     []string{},
+		},
+		{
+			Name: "Name not ForceNew",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Schema["name"].ForceNew = false
+				r.Schema["resource_group_name"].ForceNew = false
+				return r
+			},
+			Expected: []string{
+				"name-force-new azurerm_example.name",
+				"name-force-new azurerm_example.resource_group_name",
+			},
+		},
+		{
+			Name: "Location without the Schema",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Schema["location"] = &schema.Schema{
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				}
+				return r
+			},
+			Expected: []string{
+				"location-schema azurerm_example.location",
+			},
+		},
+		{
+			Name: "Optional Location",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Schema["location"] = location.SchemaOptional()
+				return r
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Tags without validation",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Schema["tags"] = &schema.Schema{
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				}
+				return r
+			},
+			Expected: []string{
+				"tags-schema azurerm_example.tags",
+			},
+		},
+		{
+			Name: "Tags within a Data Source",
+			Resource: func() *schema.Resource {
+				r := validDataSource()
+				r.Schema["tags"] = &schema.Schema{
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				}
+				return r
+			},
+			IsDataSource: true,
+			Expected: []string{
+				"tags-schema data.azurerm_example.tags",
+			},
+		},
+		{
+			Name: "No Importer",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Importer = nil
+				return r
+			},
+			Expected: []string{
+				"importer-validation azurerm_example",
+			},
+		},
+		{
+			Name: "Importer without validation",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Importer = &schema.ResourceImporter{
+					State: schema.ImportStatePassthrough,
+				}
+				return r
+			},
+			Expected: []string{
+				"importer-validation azurerm_example",
+			},
+		},
+		{
+			Name: "Importer using the Provider",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Importer = &schema.ResourceImporter{
+					State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+						_ = meta.(fmt.Stringer).String()
+						return []*schema.ResourceData{d}, nil
+					},
+				}
+				return r
+			},
+			Expected: []string{
+				"importer-validation azurerm_example",
+			},
+		},
+		{
+			Name: "MaxItems of 1 within a Set",
+			Resource: func() *schema.Resource {
+				r := validResource()
+				r.Schema["block"].Type = schema.TypeSet
+				r.Schema["block"].Elem.(*schema.Resource).Schema["nested"].Type = schema.TypeSet
+				return r
+			},
+			Expected: []string{
+				"max-items-one-list azurerm_example.block",
+				"max-items-one-list azurerm_example.block.nested",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		targets := []target{
+			{
+				name:         "azurerm_example",
+				isDataSource: v.IsDataSource,
+				resource:     v.Resource(),
+			},
+		}
+		violations, _ := run(targets, allowList{})
+
+		actual := make([]string, 0)
+		for _, violation := range violations {
+			actual = append(actual, violation.key())
+		}
+		if strings.Join(actual, "\n") != strings.Join(v.Expected, "\n") {
+			t.Fatalf("Expected the violations:\n\n%s\n\nbut got:\n\n%s", strings.Join(v.Expected, "\n"), strings.Join(actual, "\n"))
+		}
+	}
+}
+
+func TestAllowList(t *testing.T) {
+	allowed, err := parseAllowList([]string{
+		"# a comment",
+		"",
+		"importer-validation azurerm_example",
+		"  name-force-new   azurerm_other.name  ",
+	})
+	if err != nil {
+		t.Fatalf("parsing the Allow List: %+v", err)
+	}
+
+	resource := validResource()
+	resource.Importer = nil
+	targets := []target{
+		{
+			name:     "azurerm_example",
+			resource: resource,
+		},
+	}
+
+	violations, unused := run(targets, *allowed)
+	if len(violations) != 0 {
+		t.Fatalf("Expected the violations to be allowed but got %+v", violations)
+	}
+	if len(unused) != 1 || unused[0] != "name-force-new azurerm_other.name" {
+		t.Fatalf("Expected the entry for `azurerm_other` to be unused but got %+v", unused)
+	}
+}
+
+func TestAllowListInvalid(t *testing.T) {
+	testData := [][]string{
+		{"importer-validation"},
+		{"importer-validation azurerm_example something"},
+		{"not-a-rule azurerm_example"},
+		{"importer-validation azurerm_example", "importer-validation azurerm_example"},
+	}
+
+	for _, v := range testData {
+		if _, err := parseAllowList(v); err == nil {
+			t.Fatalf("Expected an error for %+v", v)
+		}
+	}
+}
+
+func TestRegisteredDataSourcesAndResources(t *testing.T) {
+	allowed, err := parseAllowListFile("allowlist.txt")
+	if err != nil {
+		t.Fatalf("parsing the Allow List: %+v", err)
+	}
+
+	targets, err := registeredTargets()
+	if err != nil {
+		t.Fatalf("retrieving the registered Data Sources and Resources: %+v", err)
+	}
+
+	violations, unused := run(targets, allowed)
+	for _, v := range violations {
+		t.Errorf("%s", v.String())
+	}
+	for _, v := range unused {
+		t.Errorf("the Allow List entry %q no longer matches a violation and should be removed", v)
+	}
+}
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+// invalidResourceId is used to confirm that the Resource ID is validated prior to import
+const invalidResourceId = "schema-lint-invalid-resource-id"
+
+type violation struct {
+	rule string
+
+	// address is the address of the Data Source/Resource, and the path to the field where applicable
+	// e.g. `azurerm_example` or `azurerm_example.some_block.some_field`
+	address string
+
+	message string
+}
+
+func (v violation) key() string {
+	return fmt.Sprintf("%s %s", v.rule, v.address)
+}
+
+func (v violation) String() string {
+	return fmt.Sprintf("%s: [%s] %s", v.address, v.rule, v.message)
+}
+
+type rule struct {
+	name        string
+	description string
+	check       func(t target) []violation
+}
+
+var rules = []rule{
+	{
+		name:        "name-force-new",
+		description: "The `name` and `resource_group_name` fields for a Resource must be ForceNew",
+		check:       checkNameForceNew,
+	},
+	{
+		name:        "location-schema",
+		description: "The `location` field for a Resource must use `location.Schema()`, `location.SchemaOptional()` or `location.SchemaWithoutForceNew()`",
+		check:       checkLocationSchema,
+	},
+	{
+		name:        "tags-schema",
+		description: "The `tags` field must use `tags.Schema()`, `tags.ForceNewSchema()`, `tags.SchemaEnforceLowerCaseKeys()` or `tags.SchemaDataSource()`",
+		check:       checkTagsSchema,
+	},
+	{
+		name:        "importer-validation",
+		description: "Resources must support import, validating the Resource ID prior to import",
+		check:       checkImporterValidation,
+	},
+	{
+		name:        "max-items-one-list",
+		description: "Blocks with a `MaxItems` of 1 must be a `TypeList`",
+		check:       checkMaxItemsOneList,
+	},
+}
+
+func rulesByName() map[string]rule {
+	output := make(map[string]rule, len(rules))
+	for _, r := range rules {
+		output[r.name] = r
+	}
+	return output
+}
+
+func checkNameForceNew(t target) []violation {
+	if t.isDataSource {
+		return nil
+	}
+
+	violations := make([]violation, 0)
+	for _, name := range []string{"name", "resource_group_name"} {
+		field, ok := t.resource.Schema[name]
+		if !ok || !field.Required || field.ForceNew {
+			continue
+		}
+
+		violations = append(violations, violation{
+			rule:    "name-force-new",
+			address: fmt.Sprintf("%s.%s", t.address(), name),
+			message: fmt.Sprintf("the field `%s` must be ForceNew", name),
+		})
+	}
+	return violations
+}
+
+func checkLocationSchema(t target) []violation {
+	if t.isDataSource {
+		// Data Sources either expose the Location (as a computed field) or use it to look up the Location
+		return nil
+	}
+
+	field, ok := t.resource.Schema["location"]
+	if !ok || (!field.Required && !field.Optional) {
+		return nil
+	}
+
+	if field.Type == schema.TypeString && funcsEqual(field.StateFunc, location.StateFunc) && funcsEqual(field.DiffSuppressFunc, location.DiffSuppressFunc) {
+		return nil
+	}
+
+	return []violation{
+		{
+			rule:    "location-schema",
+			address: fmt.Sprintf("%s.location", t.address()),
+			message: "the field `location` must use `location.Schema()`, `location.SchemaOptional()` or `location.SchemaWithoutForceNew()`",
+		},
+	}
+}
+
+func checkTagsSchema(t target) []violation {
+	field, ok := t.resource.Schema["tags"]
+	if !ok {
+		return nil
+	}
+
+	elem, ok := field.Elem.(*schema.Schema)
+	isMapOfStrings := field.Type == schema.TypeMap && ok && elem.Type == schema.TypeString
+
+	if t.isDataSource {
+		if !field.Computed || field.Optional || field.Required {
+			// Data Sources can filter by Tags, which is out of scope
+			return nil
+		}
+
+		if isMapOfStrings {
+			return nil
+		}
+
+		return []violation{
+			{
+				rule:    "tags-schema",
+				address: fmt.Sprintf("%s.tags", t.address()),
+				message: "the field `tags` must use `tags.SchemaDataSource()`",
+			},
+		}
+	}
+
+	validated := funcsEqual(field.ValidateFunc, tags.Validate) || funcsEqual(field.ValidateFunc, tags.EnforceLowerCaseKeys)
+	if isMapOfStrings && field.Optional && !field.Computed && validated {
+		return nil
+	}
+
+	return []violation{
+		{
+			rule:    "tags-schema",
+			address: fmt.Sprintf("%s.tags", t.address()),
+			message: "the field `tags` must use `tags.Schema()`, `tags.ForceNewSchema()` or `tags.SchemaEnforceLowerCaseKeys()`",
+		},
+	}
+}
+
+func checkImporterValidation(t target) []violation {
+	if t.isDataSource {
+		return nil
+	}
+
+	if t.resource.Importer == nil || t.resource.Importer.State == nil {
+		return []violation{
+			{
+				rule:    "importer-validation",
+				address: t.address(),
+				message: "the Resource must support import, using `azSchema.ValidateResourceIDPriorToImport`",
+			},
+		}
+	}
+
+	if validatesResourceIDPriorToImport(t.resource) {
+		return nil
+	}
+
+	return []violation{
+		{
+			rule:    "importer-validation",
+			address: t.address(),
+			message: "the Resource ID must be validated prior to import, using `azSchema.ValidateResourceIDPriorToImport`",
+		},
+	}
+}
+
+// validatesResourceIDPriorToImport confirms that the Importer returns an error for an invalid Resource ID, prior to
+// using the Provider - which is unavailable here, so Importers which don't validate the Resource ID are expected to panic
+func validatesResourceIDPriorToImport(resource *schema.Resource) (validates bool) {
+	// the Importers log the Resource ID being imported, which isn't relevant here
+	writer := log.Writer()
+	log.SetOutput(ioutil.Discard)
+
+	defer func() {
+		log.SetOutput(writer)
+
+		if r := recover(); r != nil {
+			validates = false
+		}
+	}()
+
+	d := resource.TestResourceData()
+	d.SetId(invalidResourceId)
+
+	_, err := resource.Importer.State(d, nil)
+	return err != nil
+}
+
+func checkMaxItemsOneList(t target) []violation {
+	violations := make([]violation, 0)
+
+	var walk func(prefix string, fields map[string]*schema.Schema)
+	walk = func(prefix string, fields map[string]*schema.Schema) {
+		for _, name := range sortedFieldNames(fields) {
+			field := fields[name]
+			address := fmt.Sprintf("%s.%s", prefix, name)
+
+			if field.MaxItems == 1 && field.Type != schema.TypeList {
+				violations = append(violations, violation{
+					rule:    "max-items-one-list",
+					address: address,
+					message: fmt.Sprintf("the field `%s` has a `MaxItems` of 1 and so must be a `TypeList`", name),
+				})
+			}
+
+			if resource, ok := field.Elem.(*schema.Resource); ok {
+				walk(address, resource.Schema)
+			}
+		}
+	}
+	walk(t.address(), t.resource.Schema)
+
+	return violations
+}
+
+// funcsEqual returns whether both values are the same function
+func funcsEqual(first interface{}, second interface{}) bool {
+	x := reflect.ValueOf(first)
+	y := reflect.ValueOf(second)
+	if x.Kind() != reflect.Func || y.Kind() != reflect.Func || x.IsNil() || y.IsNil() {
+		return false
+	}
+
+	return x.Pointer() == y.Pointer()
+}
+
+func sortedFieldNames(input map[string]*schema.Schema) []string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	blockHeaderRegex  = regexp.MustCompile("^(?:An? )?`([^`]+)`(?: blocks?)? (?:supports|exports|contains)")
+	fieldRegex        = regexp.MustCompile("^\\* `([^`]+)` - (?:\\((?:Required|Optional)\\) ?)?(.*)$")
+	introductionRegex = regexp.MustCompile("^(The following|In addition to) .*:$")
+)
+
+// documentedSection is a section of the existing documentation, from which the hand-written
+// descriptions (and any notes) are retained when the section is generated from the Schema
+type documentedSection struct {
+	// introduction is the sentence introducing the fields e.g. `The following arguments are supported:`
+	introduction string
+
+	// notes are the paragraphs between the introduction and the first field
+	notes []string
+
+	fields []*documentedField
+
+	blocks []*documentedBlock
+}
+
+type documentedBlock struct {
+	name string
+
+	// notes are the paragraphs between the block header and the first field
+	notes []string
+
+	fields []*documentedField
+}
+
+type documentedField struct {
+	name string
+
+	// description is the description of this field, excluding the `(Required)`/`(Optional)` prefix
+	description string
+
+	// notes are the paragraphs following this field, for example `~> **NOTE:**` blocks
+	notes []string
+}
+
+func (s documentedSection) block(name string) *documentedBlock {
+	for _, block := range s.blocks {
+		if block.name == name {
+			return block
+		}
+	}
+
+	return nil
+}
+
+// field returns the documented field within the specified block, where an empty block name is the top-level
+func (s documentedSection) field(blockName, name string) *documentedField {
+	fields := s.fields
+	if blockName != "" {
+		block := s.block(blockName)
+		if block == nil {
+			return nil
+		}
+		fields = block.fields
+	}
+
+	for _, field := range fields {
+		if field.name == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// parseSection parses the fields, blocks and notes from an existing section of the documentation
+func parseSection(input string) documentedSection {
+	section := documentedSection{}
+
+	var block *documentedBlock
+	var field *documentedField
+
+	appendField := func(f *documentedField) {
+		if block != nil {
+			block.fields = append(block.fields, f)
+		} else {
+			section.fields = append(section.fields, f)
+		}
+		field = f
+	}
+
+	for _, paragraph := range paragraphs(input) {
+		lines := strings.Split(paragraph, "\n")
+
+		if m := blockHeaderRegex.FindStringSubmatch(lines[0]); m != nil {
+			block = &documentedBlock{
+				name: m[1],
+			}
+			section.blocks = append(section.blocks, block)
+			field = nil
+			lines = lines[1:]
+		} else if block == nil && field == nil && introductionRegex.MatchString(lines[0]) {
+			section.introduction = lines[0]
+			lines = lines[1:]
+		}
+
+		if len(lines) == 0 {
+			continue
+		}
+
+		if !fieldRegex.MatchString(lines[0]) {
+			text := strings.Join(lines, "\n")
+			switch {
+			case field != nil:
+				field.notes = append(field.notes, text)
+			case block != nil:
+				block.notes = append(block.notes, text)
+			default:
+				section.notes = append(section.notes, text)
+			}
+			continue
+		}
+
+		for _, line := range lines {
+			if m := fieldRegex.FindStringSubmatch(line); m != nil {
+				appendField(&documentedField{
+					name:        m[1],
+					description: m[2],
+				})
+				continue
+			}
+
+			// a description spanning multiple lines
+			field.description += "\n" + line
+		}
+	}
+
+	return section
+}
+
+// paragraphs splits the input into paragraphs, excluding headings, markers and separators
+func paragraphs(input string) []string {
+	output := make([]string, 0)
+	current := make([]string, 0)
+	flush := func() {
+		if len(current) > 0 {
+			output = append(output, strings.Join(current, "\n"))
+			current = make([]string, 0)
+		}
+	}
+
+	inCodeBlock := false
+	for _, line := range strings.Split(input, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeBlock = !inCodeBlock
+		}
+
+		if !inCodeBlock {
+			if trimmed == "" || trimmed == "---" {
+				flush()
+				continue
+			}
+
+			if strings.HasPrefix(trimmed, "## ") || strings.HasPrefix(trimmed, beginMarkerPrefix) || strings.HasPrefix(trimmed, endMarkerPrefix) {
+				continue
+			}
+		}
+
+		current = append(current, line)
+	}
+	flush()
+
+	return output
+}
@@ -0,0 +1,534 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var (
+	forceNewRegex       = regexp.MustCompile("\\s*Changing this forces a new [^.]*? to be created\\.")
+	defaultValueRegex   = regexp.MustCompile("\\s*Defaults to (?:`[^`]*`|[^.`])*\\.")
+	possibleValuesRegex = regexp.MustCompile("\\s*(?:(?:Possible|Valid) (?:values|options) (?:are|include)|The only possible value is)(?:\\s*(?:`[^`]*`|,|and|or))+\\.")
+	oneOfRegex          = regexp.MustCompile("to be one of \\[(.*)\\], got")
+	timeoutRegex        = regexp.MustCompile("^\\(Defaults to [^)]*\\)\\s*")
+	timeoutBrandRegex   = regexp.MustCompile("^Used when \\w+ the (.+)\\.$")
+)
+
+type documentationGenerator struct {
+	resource *schema.Resource
+
+	// isDataSource defines if this is a Data Source (if not it's a Resource)
+	isDataSource bool
+}
+
+// render returns the content for the specified section, using the descriptions from the existing documentation
+func (gen documentationGenerator) render(section string, existing documentedSection) string {
+	switch section {
+	case sectionArguments:
+		return gen.argumentsSection(existing)
+	case sectionAttributes:
+		return gen.attributesSection(existing)
+	case sectionTimeouts:
+		return gen.timeoutsSection(existing)
+	}
+
+	return ""
+}
+
+func (gen documentationGenerator) argumentsSection(existing documentedSection) string {
+	paragraphs := []string{
+		"## Argument Reference",
+		introduction(existing, "The following arguments are supported:"),
+	}
+	paragraphs = append(paragraphs, existing.notes...)
+	paragraphs = append(paragraphs, gen.fieldsForBlock(existing, "", gen.resource.Schema, isArgument, gen.argumentForField)...)
+
+	for _, block := range orderBlocks(existing, schemaBlocks(gen.resource.Schema, isArgument)) {
+		paragraphs = append(paragraphs, "---", fmt.Sprintf("%s `%s` block supports the following:", article(block.name), block.name))
+		if documented := existing.block(block.name); documented != nil {
+			paragraphs = append(paragraphs, documented.notes...)
+		}
+		paragraphs = append(paragraphs, gen.fieldsForBlock(existing, block.name, block.fields, isAnyField, gen.argumentForField)...)
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func (gen documentationGenerator) attributesSection(existing documentedSection) string {
+	id := "The ID of the TODO."
+	if documented := existing.field("", "id"); documented != nil {
+		id = documented.description
+	}
+
+	paragraphs := []string{
+		"## Attributes Reference",
+		introduction(existing, "In addition to the Arguments listed above - the following Attributes are exported:"),
+	}
+	paragraphs = append(paragraphs, existing.notes...)
+	paragraphs = append(paragraphs, fmt.Sprintf("* `id` - %s", id))
+	if documented := existing.field("", "id"); documented != nil {
+		paragraphs = append(paragraphs, documented.notes...)
+	}
+	paragraphs = append(paragraphs, gen.fieldsForBlock(existing, "", gen.resource.Schema, isExported, gen.attributeForField)...)
+
+	for _, block := range orderBlocks(existing, schemaBlocks(gen.resource.Schema, isExported)) {
+		paragraphs = append(paragraphs, "---", fmt.Sprintf("%s `%s` block exports the following:", article(block.name), block.name))
+		if documented := existing.block(block.name); documented != nil {
+			paragraphs = append(paragraphs, documented.notes...)
+		}
+		paragraphs = append(paragraphs, gen.fieldsForBlock(existing, block.name, block.fields, isAnyField, gen.attributeForField)...)
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func (gen documentationGenerator) timeoutsSection(existing documentedSection) string {
+	if gen.resource.Timeouts == nil {
+		return ""
+	}
+
+	// the brand name used in the existing descriptions, which is used for any new timeouts
+	brandName := "TODO"
+	for _, field := range existing.fields {
+		if m := timeoutBrandRegex.FindStringSubmatch(timeoutRegex.ReplaceAllString(field.description, "")); m != nil {
+			brandName = m[1]
+			break
+		}
+	}
+
+	timeouts := []struct {
+		name     string
+		action   string
+		duration *time.Duration
+	}{
+		{name: "create", action: "creating", duration: gen.resource.Timeouts.Create},
+		{name: "read", action: "retrieving", duration: gen.resource.Timeouts.Read},
+		{name: "update", action: "updating", duration: gen.resource.Timeouts.Update},
+		{name: "delete", action: "deleting", duration: gen.resource.Timeouts.Delete},
+	}
+
+	lines := make([]string, 0)
+	for _, timeout := range timeouts {
+		if timeout.duration == nil {
+			continue
+		}
+
+		description := fmt.Sprintf("Used when %s the %s.", timeout.action, brandName)
+		if documented := existing.field("", timeout.name); documented != nil {
+			description = timeoutRegex.ReplaceAllString(documented.description, "")
+		}
+
+		lines = append(lines, fmt.Sprintf("* `%s` - (Defaults to %s) %s", timeout.name, durationToFriendlyText(*timeout.duration), description))
+	}
+
+	return fmt.Sprintf(`## Timeouts
+
+The `+"`timeouts`"+` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+%s`, strings.Join(lines, "\n"))
+}
+
+// fieldsForBlock returns the documentation for each field within the block - the fields within the existing
+// documentation are output in the same order, followed by any fields which haven't been documented yet
+func (gen documentationGenerator) fieldsForBlock(existing documentedSection, blockName string, fields map[string]*schema.Schema, include func(*schema.Schema) bool, format func(string, *schema.Schema, *documentedField) string) []string {
+	output := make([]string, 0)
+	documentedFields := existing.fields
+	if block := existing.block(blockName); blockName != "" && block != nil {
+		documentedFields = block.fields
+	}
+
+	documented := make(map[string]struct{})
+	for _, field := range documentedFields {
+		if _, ok := documented[field.name]; ok {
+			continue
+		}
+
+		v, ok := fields[field.name]
+		if !ok || !include(v) {
+			// fields which no longer exist are removed from the documentation
+			continue
+		}
+
+		documented[field.name] = struct{}{}
+		output = append(output, format(field.name, v, field))
+		output = append(output, field.notes...)
+	}
+
+	for _, name := range sortFields(fields) {
+		v := fields[name]
+		if _, ok := documented[name]; ok || !include(v) || v.Deprecated != "" {
+			continue
+		}
+
+		output = append(output, format(name, v, nil))
+	}
+
+	return output
+}
+
+func (gen documentationGenerator) argumentForField(name string, field *schema.Schema, documented *documentedField) string {
+	status := "Optional"
+	if field.Required {
+		status = "Required"
+	}
+
+	description := defaultDescription(name, field)
+	if documented != nil {
+		description = documented.description
+	}
+
+	// the notes derived from the Schema are removed and then regenerated, since these can be out of date
+	forceNew := "Changing this forces a new resource to be created."
+	if existing := forceNewRegex.FindString(description); existing != "" {
+		// the existing note can refer to the resource by name e.g. `a new Storage Account`
+		forceNew = strings.TrimSpace(existing)
+	}
+	description = forceNewRegex.ReplaceAllString(description, "")
+
+	sentences := make([]string, 0)
+	if values := possibleValues(field); len(values) > 0 {
+		description = possibleValuesRegex.ReplaceAllString(description, "")
+
+		// the possible values aren't repeated when these are already listed within the description - excluding
+		// the default value, which is regenerated below
+		if !describesValues(defaultValueRegex.ReplaceAllString(description, ""), values) {
+			if len(values) == 1 {
+				sentences = append(sentences, fmt.Sprintf("The only possible value is %s.", formatValues(values)))
+			} else {
+				sentences = append(sentences, fmt.Sprintf("Possible values are %s.", formatValues(values)))
+			}
+		}
+	}
+
+	if defaultValue := formatDefaultValue(field.Default); defaultValue != "" {
+		description = defaultValueRegex.ReplaceAllString(description, "")
+		sentences = append(sentences, fmt.Sprintf("Defaults to `%s`.", defaultValue))
+	}
+
+	if field.ForceNew && !gen.isDataSource {
+		sentences = append(sentences, forceNew)
+	}
+
+	for _, sentence := range sentences {
+		if description == "" {
+			description = sentence
+			continue
+		}
+
+		description = fmt.Sprintf("%s %s", description, sentence)
+	}
+
+	return fmt.Sprintf("* `%s` - (%s) %s", name, status, description)
+}
+
+func (gen documentationGenerator) attributeForField(name string, field *schema.Schema, documented *documentedField) string {
+	description := defaultDescription(name, field)
+	if documented != nil {
+		description = documented.description
+	}
+
+	return fmt.Sprintf("* `%s` - %s", name, description)
+}
+
+// defaultDescription returns the description for a field which hasn't been documented yet
+func defaultDescription(name string, field *schema.Schema) string {
+	if field.Description != "" {
+		return field.Description
+	}
+
+	switch name {
+	case "tags":
+		return "A mapping of tags assigned to the resource."
+	case "tags_all":
+		return "A mapping of all of the tags assigned to the resource, including those inherited from the Provider's `default_tags` block."
+	}
+
+	if _, ok := field.Elem.(*schema.Resource); ok {
+		if field.MaxItems == 1 {
+			return fmt.Sprintf("A `%s` block as defined below.", name)
+		}
+
+		return fmt.Sprintf("One or more `%s` blocks as defined below.", name)
+	}
+
+	return "TODO."
+}
+
+func isArgument(field *schema.Schema) bool {
+	return field.Required || field.Optional
+}
+
+func isAttribute(field *schema.Schema) bool {
+	return field.Computed && !field.Optional && !field.Required
+}
+
+func isAnyField(_ *schema.Schema) bool {
+	return true
+}
+
+// isExported returns whether the field is an Attribute, or is a block containing Attributes
+func isExported(field *schema.Schema) bool {
+	if isAttribute(field) {
+		return true
+	}
+
+	resource, ok := field.Elem.(*schema.Resource)
+	if !ok {
+		return false
+	}
+
+	for _, v := range resource.Schema {
+		if isExported(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type schemaBlock struct {
+	name string
+
+	// fields are the fields within this block which should be documented in this section
+	fields map[string]*schema.Schema
+}
+
+// schemaBlocks returns the blocks within the Schema which match the filter, including any nested blocks
+// - since blocks are documented by name, the fields for blocks with the same name are combined
+func schemaBlocks(input map[string]*schema.Schema, include func(*schema.Schema) bool) []schemaBlock {
+	output := make([]schemaBlock, 0)
+	indexes := make(map[string]int)
+
+	var walk func(fields map[string]*schema.Schema, include func(*schema.Schema) bool)
+	walk = func(fields map[string]*schema.Schema, include func(*schema.Schema) bool) {
+		for _, name := range sortFields(fields) {
+			field := fields[name]
+			if !include(field) {
+				continue
+			}
+
+			resource, ok := field.Elem.(*schema.Resource)
+			if !ok {
+				continue
+			}
+
+			// all of the fields within a computed block are attributes
+			nested := include
+			if isAttribute(field) {
+				nested = isAnyField
+			}
+
+			if _, exists := indexes[name]; !exists {
+				indexes[name] = len(output)
+				output = append(output, schemaBlock{
+					name:   name,
+					fields: make(map[string]*schema.Schema),
+				})
+			}
+			block := output[indexes[name]]
+			for k, v := range resource.Schema {
+				if _, exists := block.fields[k]; !exists && nested(v) {
+					block.fields[k] = v
+				}
+			}
+
+			walk(resource.Schema, nested)
+		}
+	}
+	walk(input, include)
+
+	return output
+}
+
+// orderBlocks returns the blocks in the same order as the existing documentation, followed by any new blocks
+func orderBlocks(existing documentedSection, blocks []schemaBlock) []schemaBlock {
+	output := make([]schemaBlock, 0, len(blocks))
+	added := make(map[string]struct{})
+	for _, documented := range existing.blocks {
+		for _, block := range blocks {
+			if _, ok := added[block.name]; ok || block.name != documented.name {
+				continue
+			}
+
+			added[block.name] = struct{}{}
+			output = append(output, block)
+		}
+	}
+
+	for _, block := range blocks {
+		if _, ok := added[block.name]; !ok {
+			output = append(output, block)
+		}
+	}
+
+	return output
+}
+
+// sortFields returns the names of the fields - Required fields first, then Optional, then Computed
+func sortFields(input map[string]*schema.Schema) []string {
+	names := make([]string, 0, len(input))
+	for name := range input {
+		names = append(names, name)
+	}
+
+	rank := func(field *schema.Schema) int {
+		if field.Required {
+			return 0
+		}
+		if field.Optional {
+			return 1
+		}
+		return 2
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		x, y := rank(input[names[i]]), rank(input[names[j]])
+		if x != y {
+			return x < y
+		}
+
+		return names[i] < names[j]
+	})
+	return names
+}
+
+// possibleValues returns the values allowed by the validation for this field, where these can be
+// determined - which is the case for `validation.StringInSlice` and `validation.IntInSlice`
+func possibleValues(field *schema.Schema) []string {
+	validateFunc := field.ValidateFunc
+	fieldType := field.Type
+	if elem, ok := field.Elem.(*schema.Schema); ok && validateFunc == nil {
+		validateFunc = elem.ValidateFunc
+		fieldType = elem.Type
+	}
+	if validateFunc == nil {
+		return nil
+	}
+
+	var convert func(string) (interface{}, error)
+	var probe interface{}
+	switch fieldType {
+	case schema.TypeString:
+		convert = func(input string) (interface{}, error) {
+			return input, nil
+		}
+		probe = "website-docs-probe"
+	case schema.TypeInt:
+		convert = func(input string) (interface{}, error) {
+			return strconv.Atoi(input)
+		}
+		probe = math.MinInt32
+	default:
+		return nil
+	}
+
+	validate := func(input interface{}) (errors []error) {
+		defer func() {
+			// some validation functions only support a specific type, or values in a specific format
+			if r := recover(); r != nil {
+				errors = []error{fmt.Errorf("%v", r)}
+			}
+		}()
+
+		_, errors = validateFunc(input, "")
+		return errors
+	}
+
+	for _, err := range validate(probe) {
+		m := oneOfRegex.FindStringSubmatch(err.Error())
+		if m == nil {
+			continue
+		}
+
+		// the values are output space separated, so each value is validated to confirm it was parsed correctly
+		values := strings.Fields(m[1])
+		for _, value := range values {
+			v, err := convert(value)
+			if err != nil || len(validate(v)) > 0 {
+				return nil
+			}
+		}
+
+		return values
+	}
+
+	return nil
+}
+
+// describesValues returns whether each of the possible values is listed (in backticks) within the description
+func describesValues(description string, values []string) bool {
+	for _, v := range values {
+		if !strings.Contains(description, fmt.Sprintf("`%s`", v)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatValues(input []string) string {
+	values := make([]string, 0, len(input))
+	for _, v := range input {
+		values = append(values, fmt.Sprintf("`%s`", v))
+	}
+
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return fmt.Sprintf("%s and %s", strings.Join(values[:len(values)-1], ", "), values[len(values)-1])
+}
+
+func formatDefaultValue(input interface{}) string {
+	switch v := input.(type) {
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	}
+
+	return ""
+}
+
+// introduction returns the introduction used in the existing documentation, falling back to the default
+func introduction(existing documentedSection, defaultValue string) string {
+	if existing.introduction != "" {
+		return existing.introduction
+	}
+
+	return defaultValue
+}
+
+func article(name string) string {
+	if strings.ContainsAny(name[:1], "aeiou") {
+		return "An"
+	}
+
+	return "A"
+}
+
+// durationToFriendlyText returns the duration as used throughout the documentation, where durations
+// are output in minutes unless these are a whole number of hours greater than an hour e.g. `3 hours`
+func durationToFriendlyText(duration time.Duration) string {
+	minutes := int(math.Floor(duration.Minutes()))
+	if minutes > 60 && minutes%60 == 0 {
+		return fmt.Sprintf("%d hours", minutes/60)
+	}
+
+	if minutes == 1 {
+		return "1 minute"
+	}
+
+	return fmt.Sprintf("%d minutes", minutes)
+}
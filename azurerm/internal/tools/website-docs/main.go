@@ -0,0 +1,171 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+)
+
+func main() {
+	f := flag.NewFlagSet("website-docs", flag.ExitOnError)
+
+	websitePath := f.String("website-path", "", "The relative path to the website folder")
+	resourceName := f.String("name", "", "(Optional) The name of a single Data Source/Resource which should be generated, adding the generated sections if they're not present")
+	resourceType := f.String("type", "resource", "(Optional) Whether the Data Source/Resource specified via `-name` is a Data Source (data) or a Resource (resource)")
+	check := f.Bool("check", false, "(Optional) Check that the documentation matches the Schema, rather than updating it")
+
+	_ = f.Parse(os.Args[1:])
+
+	quitWithError := func(message string) {
+		log.Print(message)
+		os.Exit(1)
+	}
+
+	if websitePath == nil || *websitePath == "" {
+		quitWithError("The Relative Website Path must be specified via `-website-path`")
+		return
+	}
+
+	if *resourceType != "data" && *resourceType != "resource" {
+		quitWithError("The type of the Data Source/Resource specified via `-type` must be either `data` or `resource`")
+		return
+	}
+
+	p, ok := provider.AzureProvider().(*schema.Provider)
+	if !ok {
+		quitWithError("The Provider wasn't a `*schema.Provider`")
+		return
+	}
+
+	docs := make([]documentationFile, 0)
+	if *resourceName != "" {
+		resources := p.ResourcesMap
+		if *resourceType == "data" {
+			resources = p.DataSourcesMap
+		}
+
+		resource, ok := resources[*resourceName]
+		if !ok {
+			quitWithError(fmt.Sprintf("The %s %q was not registered!", *resourceType, *resourceName))
+			return
+		}
+
+		docs = append(docs, newDocumentationFile(*websitePath, *resourceName, resource, *resourceType == "data"))
+	} else {
+		docs = append(docs, documentationFiles(*websitePath, p.DataSourcesMap, true)...)
+		docs = append(docs, documentationFiles(*websitePath, p.ResourcesMap, false)...)
+	}
+
+	outdated, err := run(docs, *resourceName != "", *check)
+	if err != nil {
+		quitWithError(err.Error())
+		return
+	}
+
+	if len(outdated) > 0 {
+		for _, v := range outdated {
+			log.Printf("%s doesn't match the Schema", v)
+		}
+		quitWithError("The documentation listed above is out of date - run `make website-docs` to regenerate it")
+		return
+	}
+}
+
+type documentationFile struct {
+	// path is the path to the `.html.markdown` file for this Data Source/Resource
+	path string
+
+	// resourceName is the name of the Data Source/Resource e.g. `azurerm_resource_group`
+	resourceName string
+
+	resource     *schema.Resource
+	isDataSource bool
+}
+
+func newDocumentationFile(websitePath, resourceName string, resource *schema.Resource, isDataSource bool) documentationFile {
+	resourceKind := "r"
+	if isDataSource {
+		resourceKind = "d"
+	}
+
+	fileName := fmt.Sprintf("%s.html.markdown", strings.TrimPrefix(resourceName, "azurerm_"))
+	return documentationFile{
+		path:         filepath.Join(websitePath, "docs", resourceKind, fileName),
+		resourceName: resourceName,
+		resource:     resource,
+		isDataSource: isDataSource,
+	}
+}
+
+// documentationFiles returns the documentation for each of the specified Data Sources/Resources, sorted by name
+func documentationFiles(websitePath string, resources map[string]*schema.Resource, isDataSource bool) []documentationFile {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := make([]documentationFile, 0, len(names))
+	for _, name := range names {
+		output = append(output, newDocumentationFile(websitePath, name, resources[name], isDataSource))
+	}
+	return output
+}
+
+// run regenerates the sections of the documentation generated from the Schema, returning the paths
+// of the files which are out of date when checking the documentation (rather than updating it)
+//
+// Only documentation containing the generated sections is processed, unless a single Data Source/Resource
+// was specified - in which case the generated sections replace the existing hand-written sections.
+func run(docs []documentationFile, single bool, check bool) ([]string, error) {
+	outdated := make([]string, 0)
+
+	for _, doc := range docs {
+		contents, err := ioutil.ReadFile(doc.path)
+		if err != nil {
+			if os.IsNotExist(err) && !single {
+				// the documentation for this Data Source/Resource is checked by `tfproviderdocs` instead
+				continue
+			}
+
+			return nil, fmt.Errorf("reading the documentation for %q: %+v", doc.resourceName, err)
+		}
+
+		existing := string(contents)
+		if !single && !hasGeneratedSections(existing) {
+			continue
+		}
+
+		generator := documentationGenerator{
+			resource:     doc.resource,
+			isDataSource: doc.isDataSource,
+		}
+		updated, err := generator.update(existing)
+		if err != nil {
+			return nil, fmt.Errorf("generating the documentation for %q: %+v", doc.resourceName, err)
+		}
+
+		if updated == existing {
+			continue
+		}
+
+		if check {
+			outdated = append(outdated, doc.path)
+			continue
+		}
+
+		if err := ioutil.WriteFile(doc.path, []byte(updated), 0644); err != nil {
+			return nil, fmt.Errorf("writing the documentation for %q: %+v", doc.resourceName, err)
+		}
+	}
+
+	return outdated, nil
+}
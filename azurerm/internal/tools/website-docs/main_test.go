@@ -0,0 +1,515 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/sergi/go-diff/diffmatchpatch"
+)
+
+func testResource() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"sku": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Standard",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Basic",
+					"Standard",
+				}, false),
+			},
+
+			"capacity": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"port": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const testDocumentation = `---
+subcategory: "Example"
+---
+
+# azurerm_example
+
+## Example Usage
+
+` + "```hcl" + `
+resource "azurerm_example" "example" {
+  name = "example"
+}
+
+## this isn't a heading
+` + "```" + `
+
+## Arguments Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name of this Example. Changing this forces a new Example to be created.
+
+* ` + "`sku`" + ` - (Optional) The SKU of this Example. Possible values are ` + "`Basic`" + `. Defaults to ` + "`Basic`" + `.
+
+~> **NOTE:** The SKU can only be upgraded.
+
+* ` + "`removed`" + ` - (Optional) This field no longer exists.
+
+* ` + "`rule`" + ` - One or more ` + "`rule`" + ` blocks as defined below.
+
+---
+
+A ` + "`rule`" + ` block supports the following:
+* ` + "`port`" + ` - (Optional) The port used by this Rule.
+
+## Attributes Reference
+
+The following attributes are exported:
+
+* ` + "`id`" + ` - The ID of the Example.
+
+## Timeouts
+
+The ` + "`timeouts`" + ` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+* ` + "`create`" + ` - (Defaults to 30 minutes) Used when creating the Example.
+* ` + "`delete`" + ` - (Defaults to 30 minutes) Used when deleting the Example.
+
+## Import
+
+Examples can be imported using the ` + "`resource id`" + `, e.g.
+`
+
+const testExpectedDocumentation = `---
+subcategory: "Example"
+---
+
+# azurerm_example
+
+## Example Usage
+
+` + "```hcl" + `
+resource "azurerm_example" "example" {
+  name = "example"
+}
+
+## this isn't a heading
+` + "```" + `
+
+<!-- BEGIN GENERATED SECTION: arguments -->
+## Argument Reference
+
+The following arguments are supported:
+
+* ` + "`name`" + ` - (Required) The name of this Example. Changing this forces a new Example to be created.
+
+* ` + "`sku`" + ` - (Optional) The SKU of this Example. Possible values are ` + "`Basic`" + ` and ` + "`Standard`" + `. Defaults to ` + "`Standard`" + `.
+
+~> **NOTE:** The SKU can only be upgraded.
+
+* ` + "`rule`" + ` - (Optional) One or more ` + "`rule`" + ` blocks as defined below.
+
+* ` + "`capacity`" + ` - (Optional) TODO.
+
+---
+
+A ` + "`rule`" + ` block supports the following:
+
+* ` + "`port`" + ` - (Required) The port used by this Rule.
+<!-- END GENERATED SECTION: arguments -->
+
+<!-- BEGIN GENERATED SECTION: attributes -->
+## Attributes Reference
+
+The following attributes are exported:
+
+* ` + "`id`" + ` - The ID of the Example.
+
+* ` + "`rule`" + ` - One or more ` + "`rule`" + ` blocks as defined below.
+
+* ` + "`fqdn`" + ` - TODO.
+
+---
+
+A ` + "`rule`" + ` block exports the following:
+
+* ` + "`status`" + ` - TODO.
+<!-- END GENERATED SECTION: attributes -->
+
+<!-- BEGIN GENERATED SECTION: timeouts -->
+## Timeouts
+
+The ` + "`timeouts`" + ` block allows you to specify [timeouts](https://www.terraform.io/docs/configuration/resources.html#timeouts) for certain actions:
+
+* ` + "`create`" + ` - (Defaults to 60 minutes) Used when creating the Example.
+* ` + "`read`" + ` - (Defaults to 5 minutes) Used when retrieving the Example.
+* ` + "`delete`" + ` - (Defaults to 3 hours) Used when deleting the Example.
+<!-- END GENERATED SECTION: timeouts -->
+
+## Import
+
+Examples can be imported using the ` + "`resource id`" + `, e.g.
+`
+
+func TestUpdate(t *testing.T) {
+	generator := documentationGenerator{
+		resource: testResource(),
+	}
+
+	actual, err := generator.update(testDocumentation)
+	if err != nil {
+		t.Fatalf("updating the documentation: %+v", err)
+	}
+
+	if actual != testExpectedDocumentation {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(testExpectedDocumentation, actual, false)
+		t.Fatalf("Unexpected documentation:\n\n%s", dmp.DiffPrettyText(diffs))
+	}
+
+	// regenerating the documentation shouldn't change anything
+	regenerated, err := generator.update(actual)
+	if err != nil {
+		t.Fatalf("regenerating the documentation: %+v", err)
+	}
+
+	if regenerated != actual {
+		dmp := diffmatchpatch.New()
+		diffs := dmp.DiffMain(actual, regenerated, false)
+		t.Fatalf("Expected regenerating the documentation to be a no-op:\n\n%s", dmp.DiffPrettyText(diffs))
+	}
+}
+
+func TestUpdateRemovesSections(t *testing.T) {
+	resource := testResource()
+	resource.Timeouts = nil
+
+	generator := documentationGenerator{
+		resource: resource,
+	}
+
+	actual, err := generator.update(testExpectedDocumentation)
+	if err != nil {
+		t.Fatalf("updating the documentation: %+v", err)
+	}
+
+	if strings.Contains(actual, "## Timeouts") {
+		t.Fatalf("Expected the Timeouts section to be removed but got:\n\n%s", actual)
+	}
+	if !strings.Contains(actual, "<!-- END GENERATED SECTION: attributes -->\n\n## Import") {
+		t.Fatalf("Expected the Import section to follow the Attributes section but got:\n\n%s", actual)
+	}
+}
+
+func TestUpdateMissingSection(t *testing.T) {
+	generator := documentationGenerator{
+		resource: testResource(),
+	}
+
+	if _, err := generator.update("# azurerm_example\n\n## Import\n"); err == nil {
+		t.Fatalf("Expected an error when the Arguments section doesn't exist")
+	}
+
+	if _, err := generator.update("<!-- BEGIN GENERATED SECTION: arguments -->\n## Argument Reference\n"); err == nil {
+		t.Fatalf("Expected an error when the end marker doesn't exist")
+	}
+}
+
+func TestParseSection(t *testing.T) {
+	section := parseSection(`## Attributes Reference
+
+The following attributes are exported:
+
+-> **NOTE:** These are only available after creation.
+
+* ` + "`id`" + ` - The ID of the Example.
+* ` + "`identity`" + ` - An ` + "`identity`" + ` block as defined below,
+  which is multi-line.
+
+---
+
+` + "`identity`" + ` exports the following:
+
+* ` + "`principal_id`" + ` - The Principal ID.
+
+~> **NOTE:** A note about the Principal ID.
+`)
+
+	if section.introduction != "The following attributes are exported:" {
+		t.Fatalf("Expected the introduction to be parsed but got %q", section.introduction)
+	}
+	if len(section.notes) != 1 || section.notes[0] != "-> **NOTE:** These are only available after creation." {
+		t.Fatalf("Expected a single note but got %+v", section.notes)
+	}
+	if len(section.fields) != 2 {
+		t.Fatalf("Expected 2 fields but got %d", len(section.fields))
+	}
+	if v := section.field("", "identity"); v == nil || v.description != "An `identity` block as defined below,\n  which is multi-line." {
+		t.Fatalf("Expected the multi-line description to be parsed but got %+v", v)
+	}
+
+	v := section.field("identity", "principal_id")
+	if v == nil {
+		t.Fatalf("Expected the field `principal_id` within the `identity` block")
+	}
+	if v.description != "The Principal ID." || len(v.notes) != 1 {
+		t.Fatalf("Expected the field `principal_id` to have a description and a note but got %+v", v)
+	}
+}
+
+func TestPossibleValues(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Schema   *schema.Schema
+		Expected []string
+	}{
+		{
+			Name: "StringInSlice",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Allow", "Deny"}, true),
+			},
+			Expected: []string{"Allow", "Deny"},
+		},
+		{
+			Name: "StringInSlice with a space",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"West Europe", "North Europe"}, false),
+			},
+			Expected: nil,
+		},
+		{
+			Name: "IntInSlice",
+			Schema: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntInSlice([]int{1, 2, 4}),
+			},
+			Expected: []string{"1", "2", "4"},
+		},
+		{
+			Name: "List of StringInSlice",
+			Schema: &schema.Schema{
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"GET", "PUT"}, false),
+				},
+			},
+			Expected: []string{"GET", "PUT"},
+		},
+		{
+			Name: "IntBetween",
+			Schema: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			Expected: nil,
+		},
+		{
+			Name: "No Validation",
+			Schema: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Expected: nil,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := possibleValues(v.Schema)
+		if strings.Join(actual, ",") != strings.Join(v.Expected, ",") {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestArgumentForFieldPossibleValues(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Field       string
+		Schema      *schema.Schema
+		Description string
+		Expected    string
+	}{
+		{
+			Name:  "Not Documented",
+			Field: "access_tier",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Cool", "Hot"}, false),
+			},
+			Description: "The Access Tier.",
+			Expected:    "* `access_tier` - (Optional) The Access Tier. Possible values are `Cool` and `Hot`.",
+		},
+		{
+			Name:  "Regenerated",
+			Field: "access_tier",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Cool", "Hot"}, false),
+			},
+			Description: "The Access Tier. Possible values are `Hot`.",
+			Expected:    "* `access_tier` - (Optional) The Access Tier. Possible values are `Cool` and `Hot`.",
+		},
+		{
+			Name:  "Already Described",
+			Field: "access_tier",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Cool", "Hot"}, false),
+			},
+			Description: "The Access Tier. Valid options are `Hot` and `Cool`, defaults to `Hot`.",
+			Expected:    "* `access_tier` - (Optional) The Access Tier. Valid options are `Hot` and `Cool`, defaults to `Hot`.",
+		},
+		{
+			Name:  "Already Described with a single value",
+			Field: "type",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SystemAssigned"}, false),
+			},
+			Description: "The Identity Type. At this time the only allowed value is `SystemAssigned`.",
+			Expected:    "* `type` - (Required) The Identity Type. At this time the only allowed value is `SystemAssigned`.",
+		},
+		{
+			Name:  "Only the Default Value Described",
+			Field: "sku",
+			Schema: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Basic",
+				ValidateFunc: validation.StringInSlice([]string{"Basic"}, false),
+			},
+			Description: "The SKU. Defaults to `Basic`.",
+			Expected:    "* `sku` - (Optional) The SKU. The only possible value is `Basic`. Defaults to `Basic`.",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		documented := &documentedField{
+			name:        v.Field,
+			description: v.Description,
+		}
+		actual := documentationGenerator{}.argumentForField(v.Field, v.Schema, documented)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestDurationToFriendlyText(t *testing.T) {
+	testData := map[time.Duration]string{
+		1 * time.Minute:   "1 minute",
+		5 * time.Minute:   "5 minutes",
+		60 * time.Minute:  "60 minutes",
+		90 * time.Minute:  "90 minutes",
+		2 * time.Hour:     "2 hours",
+		150 * time.Minute: "150 minutes",
+	}
+
+	for input, expected := range testData {
+		if actual := durationToFriendlyText(input); actual != expected {
+			t.Fatalf("Expected %q for %s but got %q", expected, input, actual)
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	websitePath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(websitePath, "docs", "r"), 0755); err != nil {
+		t.Fatalf("creating the website directory: %+v", err)
+	}
+
+	resources := map[string]*schema.Resource{
+		"azurerm_example":  testResource(),
+		"azurerm_existing": testResource(),
+	}
+	generated := filepath.Join(websitePath, "docs", "r", "example.html.markdown")
+	handWritten := filepath.Join(websitePath, "docs", "r", "existing.html.markdown")
+	if err := ioutil.WriteFile(generated, []byte(strings.Replace(testExpectedDocumentation, "60 minutes", "30 minutes", 1)), 0644); err != nil {
+		t.Fatalf("writing the documentation: %+v", err)
+	}
+	if err := ioutil.WriteFile(handWritten, []byte(testDocumentation), 0644); err != nil {
+		t.Fatalf("writing the documentation: %+v", err)
+	}
+
+	docs := documentationFiles(websitePath, resources, false)
+
+	// only the documentation containing the generated sections is checked
+	outdated, err := run(docs, false, true)
+	if err != nil {
+		t.Fatalf("checking the documentation: %+v", err)
+	}
+	if len(outdated) != 1 || outdated[0] != generated {
+		t.Fatalf("Expected %q to be out of date but got %+v", generated, outdated)
+	}
+
+	if _, err := run(docs, false, false); err != nil {
+		t.Fatalf("updating the documentation: %+v", err)
+	}
+	outdated, err = run(docs, false, true)
+	if err != nil {
+		t.Fatalf("checking the documentation: %+v", err)
+	}
+	if len(outdated) != 0 {
+		t.Fatalf("Expected the documentation to be up to date but got %+v", outdated)
+	}
+
+	contents, err := ioutil.ReadFile(handWritten)
+	if err != nil {
+		t.Fatalf("reading the documentation: %+v", err)
+	}
+	if string(contents) != testDocumentation {
+		t.Fatalf("Expected the hand-written documentation to be unchanged")
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	sectionArguments  = "arguments"
+	sectionAttributes = "attributes"
+	sectionTimeouts   = "timeouts"
+
+	beginMarkerPrefix = "<!-- BEGIN GENERATED SECTION: "
+	endMarkerPrefix   = "<!-- END GENERATED SECTION: "
+)
+
+// sectionHeadings are the headings used for each section in the hand-written documentation
+var sectionHeadings = map[string][]string{
+	sectionArguments:  {"## Argument Reference", "## Arguments Reference"},
+	sectionAttributes: {"## Attributes Reference", "## Attribute Reference"},
+	sectionTimeouts:   {"## Timeouts"},
+}
+
+func beginMarker(section string) string {
+	return fmt.Sprintf("%s%s -->", beginMarkerPrefix, section)
+}
+
+func endMarker(section string) string {
+	return fmt.Sprintf("%s%s -->", endMarkerPrefix, section)
+}
+
+// hasGeneratedSections returns whether the documentation contains sections generated from the Schema
+func hasGeneratedSections(input string) bool {
+	return strings.Contains(input, beginMarkerPrefix)
+}
+
+type sectionLocation struct {
+	// content is the existing content of this section, excluding the markers
+	content string
+
+	// start and end are the offsets of this section within the documentation
+	start int
+	end   int
+
+	// generated specifies whether this section is wrapped in the markers
+	generated bool
+}
+
+// findSection returns the location of the specified section within the documentation, which is either the
+// generated section (delimited by the markers) or the hand-written section (delimited by the next heading)
+func findSection(input string, section string) (*sectionLocation, error) {
+	begin := beginMarker(section)
+	end := endMarker(section)
+	if start := strings.Index(input, begin); start != -1 {
+		length := strings.Index(input[start:], end)
+		if length == -1 {
+			return nil, fmt.Errorf("the marker %q was found without the marker %q", begin, end)
+		}
+
+		return &sectionLocation{
+			content:   input[start+len(begin) : start+length],
+			start:     start,
+			end:       start + length + len(end),
+			generated: true,
+		}, nil
+	}
+
+	lines := strings.SplitAfter(input, "\n")
+	offset := 0
+	start := -1
+	inCodeBlock := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeBlock = !inCodeBlock
+		}
+
+		if !inCodeBlock && strings.HasPrefix(trimmed, "## ") {
+			if start != -1 {
+				return &sectionLocation{
+					content: input[start:offset],
+					start:   start,
+					end:     offset,
+				}, nil
+			}
+
+			for _, heading := range sectionHeadings[section] {
+				if trimmed == heading {
+					start = offset
+				}
+			}
+		}
+
+		offset += len(line)
+	}
+
+	if start != -1 {
+		return &sectionLocation{
+			content: input[start:],
+			start:   start,
+			end:     len(input),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// update returns the documentation with each section regenerated from the Schema - replacing any
+// hand-written sections with the generated sections, which are delimited by markers
+func (gen documentationGenerator) update(input string) (string, error) {
+	output := input
+
+	// the end of the previous section, used to insert sections which don't exist yet
+	previousEnd := -1
+
+	for _, section := range []string{sectionArguments, sectionAttributes, sectionTimeouts} {
+		location, err := findSection(output, section)
+		if err != nil {
+			return "", err
+		}
+
+		existing := documentedSection{}
+		if location != nil {
+			existing = parseSection(location.content)
+		}
+
+		content := gen.render(section, existing)
+		if content == "" {
+			if location != nil {
+				// e.g. a Resource which no longer supports Timeouts
+				output = output[:location.start] + strings.TrimLeft(output[location.end:], "\n")
+			}
+			continue
+		}
+
+		generated := fmt.Sprintf("%s\n%s\n%s", beginMarker(section), content, endMarker(section))
+		switch {
+		case location == nil:
+			if previousEnd == -1 {
+				return "", fmt.Errorf("the %q section couldn't be found", sectionHeadings[section][0])
+			}
+
+			output = output[:previousEnd] + "\n\n" + generated + output[previousEnd:]
+			previousEnd += len(generated) + 2
+
+		case location.generated:
+			output = output[:location.start] + generated + output[location.end:]
+			previousEnd = location.start + len(generated)
+
+		default:
+			// the hand-written section runs up until the next heading, so needs to be separated from it
+			separator := "\n\n"
+			if location.end == len(output) {
+				separator = "\n"
+			}
+			generated += separator
+
+			output = output[:location.start] + generated + output[location.end:]
+			previousEnd = location.start + len(generated) - len(separator)
+		}
+	}
+
+	return output, nil
+}